@@ -92,6 +92,7 @@ variable "service_labels" {
     "emr",
     "emrcontainers",
     "events",
+    "evidently",
     "firehose",
     "fms",
     "forecastservice",
@@ -101,6 +102,7 @@ variable "service_labels" {
     "glacier",
     "globalaccelerator",
     "glue",
+    "grafana",
     "greengrass",
     "groundstation",
     "guardduty",
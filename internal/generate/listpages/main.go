@@ -394,6 +394,7 @@ func init() {
 	awsServiceNames["cloudsearchdomain"] = "CloudSearchDomain"
 	awsServiceNames["cloudtrail"] = "CloudTrail"
 	awsServiceNames["cloudwatch"] = "CloudWatch"
+	awsServiceNames["cloudwatchevidently"] = "CloudWatchEvidently"
 	awsServiceNames["cloudwatchlogs"] = "CloudWatchLogs"
 	awsServiceNames["codeartifact"] = "CodeArtifact"
 	awsServiceNames["codebuild"] = "CodeBuild"
@@ -518,6 +519,7 @@ func init() {
 	awsServiceNames["macie"] = "Macie"
 	awsServiceNames["macie2"] = "Macie2"
 	awsServiceNames["managedblockchain"] = "ManagedBlockchain"
+	awsServiceNames["managedgrafana"] = "ManagedGrafana"
 	awsServiceNames["marketplacecatalog"] = "MarketplaceCatalog"
 	awsServiceNames["marketplacecommerceanalytics"] = "MarketplaceCommerceAnalytics"
 	awsServiceNames["marketplaceentitlement"] = "MarketplaceEntitlement"
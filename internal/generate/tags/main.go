@@ -798,6 +798,8 @@ func awsServiceName(s string) (string, error) {
 		return "directoryservice", nil
 	case "events":
 		return "eventbridge", nil
+	case "evidently":
+		return "cloudwatchevidently", nil
 	case "lexmodels":
 		return "lexmodelbuildingservice", nil
 	case "serverlessrepo":
@@ -833,6 +835,8 @@ func awsServiceNameUpper(s string) (string, error) {
 		return awsServiceNames["directoryservice"], nil
 	case "events":
 		return awsServiceNames["eventbridge"], nil
+	case "evidently":
+		return awsServiceNames["cloudwatchevidently"], nil
 	case "lexmodels":
 		return awsServiceNames["lexmodelbuildingservice"], nil
 	case "serverlessrepo":
@@ -901,6 +905,7 @@ func init() {
 	awsServiceNames["cloudsearchdomain"] = "CloudSearchDomain"
 	awsServiceNames["cloudtrail"] = "CloudTrail"
 	awsServiceNames["cloudwatch"] = "CloudWatch"
+	awsServiceNames["cloudwatchevidently"] = "CloudWatchEvidently"
 	awsServiceNames["cloudwatchlogs"] = "CloudWatchLogs"
 	awsServiceNames["codeartifact"] = "CodeArtifact"
 	awsServiceNames["codebuild"] = "CodeBuild"
@@ -1025,6 +1030,7 @@ func init() {
 	awsServiceNames["macie"] = "Macie"
 	awsServiceNames["macie2"] = "Macie2"
 	awsServiceNames["managedblockchain"] = "ManagedBlockchain"
+	awsServiceNames["managedgrafana"] = "ManagedGrafana"
 	awsServiceNames["marketplacecatalog"] = "MarketplaceCatalog"
 	awsServiceNames["marketplacecommerceanalytics"] = "MarketplaceCommerceAnalytics"
 	awsServiceNames["marketplaceentitlement"] = "MarketplaceEntitlement"
@@ -45,6 +45,9 @@ func TestAccAmplify_serial(t *testing.T) {
 			"disappears": testAccWebhook_disappears,
 			"update":     testAccWebhook_update,
 		},
+		"WebhookDataSource": {
+			"basic": testAccWebhookDataSource_basic,
+		},
 	}
 
 	for group, m := range testCases {
@@ -50,8 +50,9 @@ func ResourceWebhook() *schema.Resource {
 			},
 
 			"url": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
 			},
 		},
 	}
@@ -97,20 +98,13 @@ func resourceWebhookRead(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	webhookArn := aws.StringValue(webhook.WebhookArn)
-	arn, err := arn.Parse(webhookArn)
+	appID, err := webhookAppID(webhookArn)
 
 	if err != nil {
-		return fmt.Errorf("error parsing %q: %w", webhookArn, err)
-	}
-
-	// arn:${Partition}:amplify:${Region}:${Account}:apps/${AppId}/webhooks/${WebhookId}
-	parts := strings.Split(arn.Resource, "/")
-
-	if len(parts) != 4 {
-		return fmt.Errorf("unexpected format for ARN resource (%s)", arn.Resource)
+		return err
 	}
 
-	d.Set("app_id", parts[1])
+	d.Set("app_id", appID)
 	d.Set("arn", webhookArn)
 	d.Set("branch_name", webhook.BranchName)
 	d.Set("description", webhook.Description)
@@ -162,3 +156,21 @@ func resourceWebhookDelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// webhookAppID returns the ID of the Amplify app that owns the webhook with the specified ARN.
+func webhookAppID(webhookARN string) (string, error) {
+	arn, err := arn.Parse(webhookARN)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing %q: %w", webhookARN, err)
+	}
+
+	// arn:${Partition}:amplify:${Region}:${Account}:apps/${AppId}/webhooks/${WebhookId}
+	parts := strings.Split(arn.Resource, "/")
+
+	if len(parts) != 4 {
+		return "", fmt.Errorf("unexpected format for ARN resource (%s)", arn.Resource)
+	}
+
+	return parts[1], nil
+}
@@ -0,0 +1,70 @@
+package amplify
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+func DataSourceWebhook() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceWebhookRead,
+
+		Schema: map[string]*schema.Schema{
+			"app_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"branch_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"webhook_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceWebhookRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).AmplifyConn
+
+	id := d.Get("webhook_id").(string)
+	webhook, err := FindWebhookByID(conn, id)
+
+	if err != nil {
+		return fmt.Errorf("error reading Amplify Webhook (%s): %w", id, err)
+	}
+
+	webhookArn := aws.StringValue(webhook.WebhookArn)
+	appID, err := webhookAppID(webhookArn)
+
+	if err != nil {
+		return err
+	}
+
+	d.SetId(aws.StringValue(webhook.WebhookId))
+	d.Set("app_id", appID)
+	d.Set("arn", webhookArn)
+	d.Set("branch_name", webhook.BranchName)
+	d.Set("description", webhook.Description)
+	d.Set("url", webhook.WebhookUrl)
+
+	return nil
+}
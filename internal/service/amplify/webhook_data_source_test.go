@@ -0,0 +1,43 @@
+package amplify_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/amplify"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func testAccWebhookDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_amplify_webhook.test"
+	dataSourceName := "data.aws_amplify_webhook.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, amplify.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWebhookDataSourceConfig(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "app_id", resourceName, "app_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "branch_name", resourceName, "branch_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "url", resourceName, "url"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "webhook_id", resourceName, "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWebhookDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccWebhookConfig(rName), `
+data "aws_amplify_webhook" "test" {
+  webhook_id = aws_amplify_webhook.test.id
+}
+`)
+}
@@ -3,6 +3,7 @@ package appconfig
 import (
 	"fmt"
 	"log"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
@@ -35,6 +36,10 @@ func ResourceDeploymentStrategy() *schema.Resource {
 				Required:     true,
 				ValidateFunc: validation.IntBetween(0, 1440),
 			},
+			"deployment_strategy_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"description": {
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -135,6 +140,7 @@ func resourceDeploymentStrategyRead(d *schema.ResourceData, meta interface{}) er
 		return fmt.Errorf("error getting AppConfig Deployment Strategy (%s): empty response", d.Id())
 	}
 
+	d.Set("deployment_strategy_id", output.Id)
 	d.Set("description", output.Description)
 	d.Set("deployment_duration_in_minutes", output.DeploymentDurationInMinutes)
 	d.Set("final_bake_time_in_minutes", output.FinalBakeTimeInMinutes)
@@ -220,6 +226,12 @@ func resourceDeploymentStrategyUpdate(d *schema.ResourceData, meta interface{})
 func resourceDeploymentStrategyDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).AppConfigConn
 
+	// AWS predefined deployment strategies cannot be deleted.
+	if strings.HasPrefix(d.Id(), "AppConfig.") {
+		log.Printf("[DEBUG] Skipping deletion of predefined AppConfig Deployment Strategy (%s)", d.Id())
+		return nil
+	}
+
 	input := &appconfig.DeleteDeploymentStrategyInput{
 		DeploymentStrategyId: aws.String(d.Id()),
 	}
@@ -32,6 +32,7 @@ func TestAccAppConfigDeploymentStrategy_basic(t *testing.T) {
 					testAccCheckDeploymentStrategyExists(resourceName),
 					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "appconfig", regexp.MustCompile(`deploymentstrategy/[a-z0-9]{4,7}`)),
 					resource.TestCheckResourceAttr(resourceName, "deployment_duration_in_minutes", "3"),
+					resource.TestCheckResourceAttrPair(resourceName, "deployment_strategy_id", resourceName, "id"),
 					resource.TestCheckResourceAttr(resourceName, "growth_factor", "10"),
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "replicate_to", appconfig.ReplicateToNone),
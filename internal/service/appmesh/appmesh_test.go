@@ -7,12 +7,13 @@ import (
 func TestAccAppMesh_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"GatewayRoute": {
-			"basic":      testAccGatewayRoute_basic,
-			"disappears": testAccGatewayRoute_disappears,
-			"grpcRoute":  testAccGatewayRoute_GRPCRoute,
-			"httpRoute":  testAccGatewayRoute_HTTPRoute,
-			"http2Route": testAccGatewayRoute_HTTP2Route,
-			"tags":       testAccGatewayRoute_Tags,
+			"basic":                    testAccGatewayRoute_basic,
+			"disappears":               testAccGatewayRoute_disappears,
+			"grpcRoute":                testAccGatewayRoute_GRPCRoute,
+			"grpcRouteRewriteMetadata": testAccGatewayRoute_GRPCRouteRewriteMetadata,
+			"httpRoute":                testAccGatewayRoute_HTTPRoute,
+			"http2Route":               testAccGatewayRoute_HTTP2Route,
+			"tags":                     testAccGatewayRoute_Tags,
 		},
 		"Mesh": {
 			"basic":        testAccMesh_basic,
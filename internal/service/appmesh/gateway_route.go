@@ -105,6 +105,32 @@ func ResourceGatewayRoute() *schema.Resource {
 														},
 													},
 												},
+
+												"rewrite": {
+													Type:     schema.TypeList,
+													Optional: true,
+													MinItems: 0,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"hostname": {
+																Type:     schema.TypeList,
+																Required: true,
+																MinItems: 1,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"default_target_hostname": {
+																			Type:         schema.TypeString,
+																			Required:     true,
+																			ValidateFunc: validation.StringInSlice(appmesh.DefaultGatewayRouteRewrite_Values(), false),
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
 											},
 										},
 									},
@@ -116,6 +142,82 @@ func ResourceGatewayRoute() *schema.Resource {
 										MaxItems: 1,
 										Elem: &schema.Resource{
 											Schema: map[string]*schema.Schema{
+												"metadata": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													MinItems: 0,
+													MaxItems: 10,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"invert": {
+																Type:     schema.TypeBool,
+																Optional: true,
+																Default:  false,
+															},
+
+															"match": {
+																Type:     schema.TypeList,
+																Optional: true,
+																MinItems: 0,
+																MaxItems: 1,
+																Elem: &schema.Resource{
+																	Schema: map[string]*schema.Schema{
+																		"exact": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: validation.StringLenBetween(1, 255),
+																		},
+
+																		"prefix": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: validation.StringLenBetween(1, 255),
+																		},
+
+																		"range": {
+																			Type:     schema.TypeList,
+																			Optional: true,
+																			MinItems: 0,
+																			MaxItems: 1,
+																			Elem: &schema.Resource{
+																				Schema: map[string]*schema.Schema{
+																					"end": {
+																						Type:     schema.TypeInt,
+																						Required: true,
+																					},
+
+																					"start": {
+																						Type:     schema.TypeInt,
+																						Required: true,
+																					},
+																				},
+																			},
+																		},
+
+																		"regex": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: validation.StringLenBetween(1, 255),
+																		},
+
+																		"suffix": {
+																			Type:         schema.TypeString,
+																			Optional:     true,
+																			ValidateFunc: validation.StringLenBetween(1, 255),
+																		},
+																	},
+																},
+															},
+
+															"name": {
+																Type:         schema.TypeString,
+																Required:     true,
+																ValidateFunc: validation.StringLenBetween(1, 50),
+															},
+														},
+													},
+												},
+
 												"service_name": {
 													Type:     schema.TypeString,
 													Required: true,
@@ -561,6 +663,24 @@ func expandAppmeshGrpcGatewayRoute(vGrpcRoute []interface{}) *appmesh.GrpcGatewa
 
 		mRouteAction := vRouteAction[0].(map[string]interface{})
 
+		if vRouteRewrite, ok := mRouteAction["rewrite"].([]interface{}); ok && len(vRouteRewrite) > 0 && vRouteRewrite[0] != nil {
+			routeRewrite := &appmesh.GrpcGatewayRouteRewrite{}
+
+			mRouteRewrite := vRouteRewrite[0].(map[string]interface{})
+
+			if vHostname, ok := mRouteRewrite["hostname"].([]interface{}); ok && len(vHostname) > 0 && vHostname[0] != nil {
+				mHostname := vHostname[0].(map[string]interface{})
+
+				if vDefaultTargetHostname, ok := mHostname["default_target_hostname"].(string); ok && vDefaultTargetHostname != "" {
+					routeRewrite.Hostname = &appmesh.GatewayRouteHostnameRewrite{
+						DefaultTargetHostname: aws.String(vDefaultTargetHostname),
+					}
+				}
+			}
+
+			routeAction.Rewrite = routeRewrite
+		}
+
 		if vRouteTarget, ok := mRouteAction["target"].([]interface{}); ok {
 			routeAction.Target = expandAppmeshGatewayRouteTarget(vRouteTarget)
 		}
@@ -573,6 +693,59 @@ func expandAppmeshGrpcGatewayRoute(vGrpcRoute []interface{}) *appmesh.GrpcGatewa
 
 		mRouteMatch := vRouteMatch[0].(map[string]interface{})
 
+		if vMetadatas, ok := mRouteMatch["metadata"].(*schema.Set); ok && vMetadatas.Len() > 0 {
+			metadatas := []*appmesh.GrpcGatewayRouteMetadata{}
+
+			for _, vMetadata := range vMetadatas.List() {
+				metadata := &appmesh.GrpcGatewayRouteMetadata{}
+
+				mMetadata := vMetadata.(map[string]interface{})
+
+				if vInvert, ok := mMetadata["invert"].(bool); ok {
+					metadata.Invert = aws.Bool(vInvert)
+				}
+				if vName, ok := mMetadata["name"].(string); ok && vName != "" {
+					metadata.Name = aws.String(vName)
+				}
+
+				if vMatch, ok := mMetadata["match"].([]interface{}); ok && len(vMatch) > 0 && vMatch[0] != nil {
+					metadata.Match = &appmesh.GrpcMetadataMatchMethod{}
+
+					mMatch := vMatch[0].(map[string]interface{})
+
+					if vExact, ok := mMatch["exact"].(string); ok && vExact != "" {
+						metadata.Match.Exact = aws.String(vExact)
+					}
+					if vPrefix, ok := mMatch["prefix"].(string); ok && vPrefix != "" {
+						metadata.Match.Prefix = aws.String(vPrefix)
+					}
+					if vRegex, ok := mMatch["regex"].(string); ok && vRegex != "" {
+						metadata.Match.Regex = aws.String(vRegex)
+					}
+					if vSuffix, ok := mMatch["suffix"].(string); ok && vSuffix != "" {
+						metadata.Match.Suffix = aws.String(vSuffix)
+					}
+
+					if vRange, ok := mMatch["range"].([]interface{}); ok && len(vRange) > 0 && vRange[0] != nil {
+						metadata.Match.Range = &appmesh.MatchRange{}
+
+						mRange := vRange[0].(map[string]interface{})
+
+						if vEnd, ok := mRange["end"].(int); ok && vEnd > 0 {
+							metadata.Match.Range.End = aws.Int64(int64(vEnd))
+						}
+						if vStart, ok := mRange["start"].(int); ok && vStart > 0 {
+							metadata.Match.Range.Start = aws.Int64(int64(vStart))
+						}
+					}
+				}
+
+				metadatas = append(metadatas, metadata)
+			}
+
+			routeMatch.Metadata = metadatas
+		}
+
 		if vServiceName, ok := mRouteMatch["service_name"].(string); ok && vServiceName != "" {
 			routeMatch.ServiceName = aws.String(vServiceName)
 		}
@@ -663,11 +836,57 @@ func flattenAppmeshGrpcGatewayRoute(grpcRoute *appmesh.GrpcGatewayRoute) []inter
 			"target": flattenAppmeshGatewayRouteTarget(routeAction.Target),
 		}
 
+		if routeRewrite := routeAction.Rewrite; routeRewrite != nil {
+			mRouteRewrite := map[string]interface{}{}
+
+			if hostname := routeRewrite.Hostname; hostname != nil {
+				mHostname := map[string]interface{}{
+					"default_target_hostname": aws.StringValue(hostname.DefaultTargetHostname),
+				}
+
+				mRouteRewrite["hostname"] = []interface{}{mHostname}
+			}
+
+			mRouteAction["rewrite"] = []interface{}{mRouteRewrite}
+		}
+
 		mGrpcRoute["action"] = []interface{}{mRouteAction}
 	}
 
 	if routeMatch := grpcRoute.Match; routeMatch != nil {
+		vMetadatas := []interface{}{}
+
+		for _, metadata := range routeMatch.Metadata {
+			mMetadata := map[string]interface{}{
+				"invert": aws.BoolValue(metadata.Invert),
+				"name":   aws.StringValue(metadata.Name),
+			}
+
+			if match := metadata.Match; match != nil {
+				mMatch := map[string]interface{}{
+					"exact":  aws.StringValue(match.Exact),
+					"prefix": aws.StringValue(match.Prefix),
+					"regex":  aws.StringValue(match.Regex),
+					"suffix": aws.StringValue(match.Suffix),
+				}
+
+				if r := match.Range; r != nil {
+					mRange := map[string]interface{}{
+						"end":   int(aws.Int64Value(r.End)),
+						"start": int(aws.Int64Value(r.Start)),
+					}
+
+					mMatch["range"] = []interface{}{mRange}
+				}
+
+				mMetadata["match"] = []interface{}{mMatch}
+			}
+
+			vMetadatas = append(vMetadatas, mMetadata)
+		}
+
 		mRouteMatch := map[string]interface{}{
+			"metadata":     vMetadatas,
 			"service_name": aws.StringValue(routeMatch.ServiceName),
 		}
 
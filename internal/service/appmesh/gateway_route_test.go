@@ -160,6 +160,62 @@ func testAccGatewayRoute_GRPCRoute(t *testing.T) {
 	})
 }
 
+func testAccGatewayRoute_GRPCRouteRewriteMetadata(t *testing.T) {
+	var v appmesh.GatewayRouteData
+	resourceName := "aws_appmesh_gateway_route.test"
+	vsResourceName := "aws_appmesh_virtual_service.test.0"
+	meshName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	vgName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	grName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(appmesh.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, appmesh.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAppmeshGatewayRouteDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAppmeshGatewayRouteConfigGrpcRouteRewriteMetadata(meshName, vgName, grName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAppmeshGatewayRouteExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "spec.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.action.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.action.0.rewrite.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.action.0.rewrite.0.hostname.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.action.0.rewrite.0.hostname.0.default_target_hostname", "DISABLED"),
+					resource.TestCheckResourceAttrPair(resourceName, "spec.0.grpc_route.0.action.0.target.0.virtual_service.0.virtual_service_name", vsResourceName, "name"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.match.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.match.0.metadata.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "spec.0.grpc_route.0.match.0.metadata.*", map[string]string{
+						"invert":        "false",
+						"match.#":       "1",
+						"match.0.exact": "value1",
+						"name":          "header1",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "spec.0.grpc_route.0.match.0.metadata.*", map[string]string{
+						"invert":                "true",
+						"match.#":               "1",
+						"match.0.range.#":       "1",
+						"match.0.range.0.end":   "7",
+						"match.0.range.0.start": "2",
+						"name":                  "header2",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.grpc_route.0.match.0.service_name", "test1"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http2_route.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "spec.0.http_route.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportStateIdFunc: testAccGatewayRouteImportStateIdFunc(resourceName),
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccGatewayRoute_HTTPRoute(t *testing.T) {
 	var v appmesh.GatewayRouteData
 	resourceName := "aws_appmesh_gateway_route.test"
@@ -493,6 +549,58 @@ resource "aws_appmesh_gateway_route" "test" {
 `, grName))
 }
 
+func testAccAppmeshGatewayRouteConfigGrpcRouteRewriteMetadata(meshName, vgName, grName string) string {
+	return acctest.ConfigCompose(testAccAppmeshGatewayRouteConfigBase(meshName, vgName), fmt.Sprintf(`
+resource "aws_appmesh_gateway_route" "test" {
+  name                 = %[1]q
+  mesh_name            = aws_appmesh_mesh.test.name
+  virtual_gateway_name = aws_appmesh_virtual_gateway.test.name
+
+  spec {
+    grpc_route {
+      action {
+        target {
+          virtual_service {
+            virtual_service_name = aws_appmesh_virtual_service.test[0].name
+          }
+        }
+
+        rewrite {
+          hostname {
+            default_target_hostname = "DISABLED"
+          }
+        }
+      }
+
+      match {
+        service_name = "test1"
+
+        metadata {
+          name = "header1"
+
+          match {
+            exact = "value1"
+          }
+        }
+
+        metadata {
+          name   = "header2"
+          invert = true
+
+          match {
+            range {
+              start = 2
+              end   = 7
+            }
+          }
+        }
+      }
+    }
+  }
+}
+`, grName))
+}
+
 func testAccAppmeshGatewayRouteConfigHttpRoute(meshName, vgName, grName string) string {
 	return acctest.ConfigCompose(testAccAppmeshGatewayRouteConfigBase(meshName, vgName), fmt.Sprintf(`
 resource "aws_appmesh_gateway_route" "test" {
@@ -0,0 +1,114 @@
+package apprunner
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+)
+
+func DataSourceAutoScalingConfigurationVersion() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceAutoScalingConfigurationVersionRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"auto_scaling_configuration_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"auto_scaling_configuration_revision": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"latest": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"max_concurrency": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"max_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"min_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceAutoScalingConfigurationVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AppRunnerConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name := d.Get("auto_scaling_configuration_name").(string)
+	revision := int64(d.Get("auto_scaling_configuration_revision").(int))
+
+	summary, err := FindAutoScalingConfigurationSummary(ctx, conn, name, revision)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing App Runner AutoScaling Configuration Versions (%s): %w", name, err))
+	}
+
+	if summary == nil {
+		return diag.FromErr(fmt.Errorf("error reading App Runner AutoScaling Configuration Version (%s): not found", name))
+	}
+
+	arn := aws.StringValue(summary.AutoScalingConfigurationArn)
+
+	output, err := conn.DescribeAutoScalingConfigurationWithContext(ctx, &apprunner.DescribeAutoScalingConfigurationInput{
+		AutoScalingConfigurationArn: aws.String(arn),
+	})
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error reading App Runner AutoScaling Configuration Version (%s): %w", arn, err))
+	}
+
+	if output == nil || output.AutoScalingConfiguration == nil {
+		return diag.FromErr(fmt.Errorf("error reading App Runner AutoScaling Configuration Version (%s): empty output", arn))
+	}
+
+	config := output.AutoScalingConfiguration
+
+	d.SetId(arn)
+	d.Set("arn", arn)
+	d.Set("auto_scaling_configuration_name", config.AutoScalingConfigurationName)
+	d.Set("auto_scaling_configuration_revision", config.AutoScalingConfigurationRevision)
+	d.Set("latest", config.Latest)
+	d.Set("max_concurrency", config.MaxConcurrency)
+	d.Set("max_size", config.MaxSize)
+	d.Set("min_size", config.MinSize)
+	d.Set("status", config.Status)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing tags for App Runner AutoScaling Configuration Version (%s): %w", arn, err))
+	}
+
+	if err := d.Set("tags", tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package apprunner_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/apprunner"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccAppRunnerAutoScalingConfigurationVersionDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_apprunner_auto_scaling_configuration_version.test"
+	resourceName := "aws_apprunner_auto_scaling_configuration_version.other"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckAppRunner(t) },
+		ErrorCheck: acctest.ErrorCheck(t, apprunner.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutoScalingConfigurationVersionDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "auto_scaling_configuration_name", resourceName, "auto_scaling_configuration_name"),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_configuration_revision", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "latest", "true"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "max_concurrency", resourceName, "max_concurrency"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "max_size", resourceName, "max_size"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "min_size", resourceName, "min_size"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAppRunnerAutoScalingConfigurationVersionDataSource_revision(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_apprunner_auto_scaling_configuration_version.test"
+	resourceName := "aws_apprunner_auto_scaling_configuration_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheckAppRunner(t) },
+		ErrorCheck: acctest.ErrorCheck(t, apprunner.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAutoScalingConfigurationVersionDataSourceRevisionConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttr(dataSourceName, "auto_scaling_configuration_revision", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "latest", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccAutoScalingConfigurationVersionDataSourceConfigBase(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_apprunner_auto_scaling_configuration_version" "test" {
+  auto_scaling_configuration_name = %[1]q
+}
+
+resource "aws_apprunner_auto_scaling_configuration_version" "other" {
+  auto_scaling_configuration_name = aws_apprunner_auto_scaling_configuration_version.test.auto_scaling_configuration_name
+
+  max_concurrency = 125
+  max_size        = 20
+
+  tags = {
+    Name = %[1]q
+  }
+}
+`, rName)
+}
+
+func testAccAutoScalingConfigurationVersionDataSourceConfig(rName string) string {
+	return acctest.ConfigCompose(testAccAutoScalingConfigurationVersionDataSourceConfigBase(rName), `
+data "aws_apprunner_auto_scaling_configuration_version" "test" {
+  auto_scaling_configuration_name = aws_apprunner_auto_scaling_configuration_version.other.auto_scaling_configuration_name
+}
+`)
+}
+
+func testAccAutoScalingConfigurationVersionDataSourceRevisionConfig(rName string) string {
+	return acctest.ConfigCompose(testAccAutoScalingConfigurationVersionDataSourceConfigBase(rName), `
+data "aws_apprunner_auto_scaling_configuration_version" "test" {
+  auto_scaling_configuration_name     = aws_apprunner_auto_scaling_configuration_version.other.auto_scaling_configuration_name
+  auto_scaling_configuration_revision = aws_apprunner_auto_scaling_configuration_version.test.auto_scaling_configuration_revision
+}
+`)
+}
@@ -80,3 +80,47 @@ func FindCustomDomain(ctx context.Context, conn *apprunner.AppRunner, domainName
 
 	return customDomain, nil
 }
+
+func FindAutoScalingConfigurationSummary(ctx context.Context, conn *apprunner.AppRunner, name string, revision int64) (*apprunner.AutoScalingConfigurationSummary, error) {
+	input := &apprunner.ListAutoScalingConfigurationsInput{
+		AutoScalingConfigurationName: aws.String(name),
+		LatestOnly:                   aws.Bool(revision == 0),
+	}
+
+	var summary *apprunner.AutoScalingConfigurationSummary
+
+	err := conn.ListAutoScalingConfigurationsPagesWithContext(ctx, input, func(page *apprunner.ListAutoScalingConfigurationsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, s := range page.AutoScalingConfigurationSummaryList {
+			if s == nil {
+				continue
+			}
+
+			if aws.StringValue(s.AutoScalingConfigurationName) != name {
+				continue
+			}
+
+			if revision != 0 && aws.Int64Value(s.AutoScalingConfigurationRevision) != revision {
+				continue
+			}
+
+			summary = s
+			return false
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	if summary == nil {
+		return nil, nil
+	}
+
+	return summary, nil
+}
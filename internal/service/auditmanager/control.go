@@ -0,0 +1,395 @@
+package auditmanager
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceControl() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceControlCreate,
+		ReadContext:   resourceControlRead,
+		UpdateContext: resourceControlUpdate,
+		DeleteContext: resourceControlDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"action_plan_instructions": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"action_plan_title": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 300),
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"control_mapping_sources": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 100,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1000),
+						},
+						"source_frequency": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice(auditmanager.SourceFrequency_Values(), false),
+						},
+						"source_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_keyword": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"keyword_input_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(auditmanager.KeywordInputType_Values(), false),
+									},
+									"keyword_value": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringLenBetween(1, 100),
+									},
+								},
+							},
+						},
+						"source_name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 100),
+						},
+						"source_set_up_option": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(auditmanager.SourceSetUpOption_Values(), false),
+						},
+						"source_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(auditmanager.SourceType_Values(), false),
+						},
+						"troubleshooting_text": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 1000),
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 300),
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"testing_information": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 1000),
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceControlCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &auditmanager.CreateControlInput{
+		ControlMappingSources: expandCreateControlMappingSources(d.Get("control_mapping_sources").(*schema.Set).List()),
+		Name:                  aws.String(name),
+	}
+
+	if v, ok := d.GetOk("action_plan_instructions"); ok {
+		input.ActionPlanInstructions = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("action_plan_title"); ok {
+		input.ActionPlanTitle = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("testing_information"); ok {
+		input.TestingInformation = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Audit Manager Control: %s", input)
+	output, err := conn.CreateControlWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating Audit Manager Control (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Control.Id))
+
+	return resourceControlRead(ctx, d, meta)
+}
+
+func resourceControlRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	control, err := FindControlByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Audit Manager Control (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Audit Manager Control (%s): %s", d.Id(), err)
+	}
+
+	d.Set("action_plan_instructions", control.ActionPlanInstructions)
+	d.Set("action_plan_title", control.ActionPlanTitle)
+	d.Set("arn", control.Arn)
+
+	if err := d.Set("control_mapping_sources", flattenControlMappingSources(control.ControlMappingSources)); err != nil {
+		return diag.Errorf("error setting control_mapping_sources: %s", err)
+	}
+
+	d.Set("description", control.Description)
+	d.Set("name", control.Name)
+	d.Set("testing_information", control.TestingInformation)
+	d.Set("type", control.Type)
+
+	tags := KeyValueTags(control.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceControlUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &auditmanager.UpdateControlInput{
+			ActionPlanInstructions: aws.String(d.Get("action_plan_instructions").(string)),
+			ActionPlanTitle:        aws.String(d.Get("action_plan_title").(string)),
+			ControlId:              aws.String(d.Id()),
+			ControlMappingSources:  expandControlMappingSources(d.Get("control_mapping_sources").(*schema.Set).List()),
+			Description:            aws.String(d.Get("description").(string)),
+			Name:                   aws.String(d.Get("name").(string)),
+			TestingInformation:     aws.String(d.Get("testing_information").(string)),
+		}
+
+		log.Printf("[DEBUG] Updating Audit Manager Control: %s", input)
+		_, err := conn.UpdateControlWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating Audit Manager Control (%s): %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating Audit Manager Control (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceControlRead(ctx, d, meta)
+}
+
+func resourceControlDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).AuditManagerConn
+
+	log.Printf("[DEBUG] Deleting Audit Manager Control: %s", d.Id())
+	_, err := conn.DeleteControlWithContext(ctx, &auditmanager.DeleteControlInput{
+		ControlId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting Audit Manager Control (%s): %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandCreateControlMappingSources(tfList []interface{}) []*auditmanager.CreateControlMappingSource {
+	var apiObjects []*auditmanager.CreateControlMappingSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &auditmanager.CreateControlMappingSource{
+			SourceName:        aws.String(tfMap["source_name"].(string)),
+			SourceSetUpOption: aws.String(tfMap["source_set_up_option"].(string)),
+			SourceType:        aws.String(tfMap["source_type"].(string)),
+		}
+
+		if v, ok := tfMap["source_description"].(string); ok && v != "" {
+			apiObject.SourceDescription = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_frequency"].(string); ok && v != "" {
+			apiObject.SourceFrequency = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_keyword"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SourceKeyword = expandSourceKeyword(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["troubleshooting_text"].(string); ok && v != "" {
+			apiObject.TroubleshootingText = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandControlMappingSources(tfList []interface{}) []*auditmanager.ControlMappingSource {
+	var apiObjects []*auditmanager.ControlMappingSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &auditmanager.ControlMappingSource{
+			SourceName:        aws.String(tfMap["source_name"].(string)),
+			SourceSetUpOption: aws.String(tfMap["source_set_up_option"].(string)),
+			SourceType:        aws.String(tfMap["source_type"].(string)),
+		}
+
+		if v, ok := tfMap["source_description"].(string); ok && v != "" {
+			apiObject.SourceDescription = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_frequency"].(string); ok && v != "" {
+			apiObject.SourceFrequency = aws.String(v)
+		}
+
+		// Existing sources are identified by ID; new sources have none yet.
+		if v, ok := tfMap["source_id"].(string); ok && v != "" {
+			apiObject.SourceId = aws.String(v)
+		}
+
+		if v, ok := tfMap["source_keyword"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			apiObject.SourceKeyword = expandSourceKeyword(v[0].(map[string]interface{}))
+		}
+
+		if v, ok := tfMap["troubleshooting_text"].(string); ok && v != "" {
+			apiObject.TroubleshootingText = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandSourceKeyword(tfMap map[string]interface{}) *auditmanager.SourceKeyword {
+	if tfMap == nil {
+		return nil
+	}
+
+	return &auditmanager.SourceKeyword{
+		KeywordInputType: aws.String(tfMap["keyword_input_type"].(string)),
+		KeywordValue:     aws.String(tfMap["keyword_value"].(string)),
+	}
+}
+
+func flattenControlMappingSources(apiObjects []*auditmanager.ControlMappingSource) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"source_description":   aws.StringValue(apiObject.SourceDescription),
+			"source_frequency":     aws.StringValue(apiObject.SourceFrequency),
+			"source_id":            aws.StringValue(apiObject.SourceId),
+			"source_name":          aws.StringValue(apiObject.SourceName),
+			"source_set_up_option": aws.StringValue(apiObject.SourceSetUpOption),
+			"source_type":          aws.StringValue(apiObject.SourceType),
+			"troubleshooting_text": aws.StringValue(apiObject.TroubleshootingText),
+		}
+
+		if v := apiObject.SourceKeyword; v != nil {
+			tfMap["source_keyword"] = []interface{}{map[string]interface{}{
+				"keyword_input_type": aws.StringValue(v.KeywordInputType),
+				"keyword_value":      aws.StringValue(v.KeywordValue),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,123 @@
+package auditmanager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/auditmanager"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindAssessmentByID(ctx context.Context, conn *auditmanager.AuditManager, id string) (*auditmanager.Assessment, error) {
+	input := &auditmanager.GetAssessmentInput{
+		AssessmentId: aws.String(id),
+	}
+
+	output, err := conn.GetAssessmentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Assessment == nil || output.Assessment.Metadata == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Assessment, nil
+}
+
+func FindControlByID(ctx context.Context, conn *auditmanager.AuditManager, id string) (*auditmanager.Control, error) {
+	input := &auditmanager.GetControlInput{
+		ControlId: aws.String(id),
+	}
+
+	output, err := conn.GetControlWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Control == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Control, nil
+}
+
+func FindFrameworkByID(ctx context.Context, conn *auditmanager.AuditManager, id string) (*auditmanager.Framework, error) {
+	input := &auditmanager.GetAssessmentFrameworkInput{
+		FrameworkId: aws.String(id),
+	}
+
+	output, err := conn.GetAssessmentFrameworkWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, auditmanager.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Framework == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Framework, nil
+}
+
+func FindFrameworkMetadataByNameAndType(ctx context.Context, conn *auditmanager.AuditManager, name, frameworkType string) (*auditmanager.AssessmentFrameworkMetadata, error) {
+	input := &auditmanager.ListAssessmentFrameworksInput{
+		FrameworkType: aws.String(frameworkType),
+	}
+	var results []*auditmanager.AssessmentFrameworkMetadata
+
+	for {
+		output, err := conn.ListAssessmentFrameworksWithContext(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range output.FrameworkMetadataList {
+			if v != nil && aws.StringValue(v.Name) == name {
+				results = append(results, v)
+			}
+		}
+
+		if aws.StringValue(output.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+
+	if len(results) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(results); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return results[0], nil
+}
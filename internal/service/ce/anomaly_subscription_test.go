@@ -0,0 +1,171 @@
+package ce_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/costexplorer"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfce "github.com/hashicorp/terraform-provider-aws/internal/service/ce"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccCEAnomalySubscription_basic(t *testing.T) {
+	resourceName := "aws_ce_anomaly_subscription.test"
+	monitorResourceName := "aws_ce_anomaly_monitor.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(costexplorer.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionConfig(rName, address, "DAILY", 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					acctest.CheckResourceAttrAccountID(resourceName, "account_id"),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "arn", "ce", regexp.MustCompile(`anomalysubscription/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "DAILY"),
+					resource.TestCheckResourceAttr(resourceName, "monitor_arn_list.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "monitor_arn_list.0", monitorResourceName, "arn"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "subscriber.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "subscriber.*", map[string]string{
+						"address": address,
+						"type":    costexplorer.SubscriberTypeEmail,
+					}),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "100"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_disappears(t *testing.T) {
+	resourceName := "aws_ce_anomaly_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(costexplorer.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionConfig(rName, address, "DAILY", 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfce.ResourceAnomalySubscription(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccCEAnomalySubscription_update(t *testing.T) {
+	resourceName := "aws_ce_anomaly_subscription.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	domain := acctest.RandomDomainName()
+	address := acctest.RandomEmailAddress(domain)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(costexplorer.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, costexplorer.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckAnomalySubscriptionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAnomalySubscriptionConfig(rName, address, "DAILY", 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "DAILY"),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "100"),
+				),
+			},
+			{
+				Config: testAccAnomalySubscriptionConfig(rName, address, "WEEKLY", 200),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAnomalySubscriptionExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "frequency", "WEEKLY"),
+					resource.TestCheckResourceAttr(resourceName, "threshold", "200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAnomalySubscriptionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Cost Explorer Anomaly Subscription ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+		_, err := tfce.FindAnomalySubscriptionByARN(conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckAnomalySubscriptionDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).CostExplorerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ce_anomaly_subscription" {
+			continue
+		}
+
+		_, err := tfce.FindAnomalySubscriptionByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Cost Explorer Anomaly Subscription %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccAnomalySubscriptionConfig(rName, address, frequency string, threshold int) string {
+	return acctest.ConfigCompose(testAccAnomalyMonitorConfig(rName), fmt.Sprintf(`
+resource "aws_ce_anomaly_subscription" "test" {
+  name             = %[1]q
+  frequency        = %[3]q
+  monitor_arn_list = [aws_ce_anomaly_monitor.test.arn]
+  threshold        = %[4]d
+
+  subscriber {
+    address = %[2]q
+    type    = "EMAIL"
+  }
+}
+`, rName, address, frequency, threshold))
+}
@@ -1,12 +1,14 @@
 package cloudtrail
 
 import (
+	"context"
 	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -241,7 +243,10 @@ func ResourceCloudTrail() *schema.Resource {
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.All(
+			resourceCloudTrailAdvancedEventSelectorCustomizeDiff,
+			verify.SetTagsDiff,
+		),
 	}
 }
 
@@ -793,6 +798,103 @@ func expandAdvancedEventSelectorFieldSelector(configured *schema.Set) []*cloudtr
 	return fieldSelectors
 }
 
+// resourceCloudTrailAdvancedEventSelectorCustomizeDiff validates the operators used in each
+// advanced event selector field selector against the rules documented for the field.
+func resourceCloudTrailAdvancedEventSelectorCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	for i, v := range diff.Get("advanced_event_selector").([]interface{}) {
+		tfMap, ok := v.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		fieldSelectors, ok := tfMap["field_selector"].(*schema.Set)
+
+		if !ok {
+			continue
+		}
+
+		for _, v := range fieldSelectors.List() {
+			tfMap, ok := v.(map[string]interface{})
+
+			if !ok {
+				continue
+			}
+
+			if err := validateAdvancedEventSelectorFieldSelector(tfMap); err != nil {
+				return fmt.Errorf("advanced_event_selector.%d: %w", i, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateAdvancedEventSelectorFieldSelector(tfMap map[string]interface{}) error {
+	field := tfMap["field"].(string)
+
+	// Field values are unknown until apply.
+	if field == "" {
+		return nil
+	}
+
+	var operators []string
+
+	for _, operator := range []string{"ends_with", "equals", "not_ends_with", "not_equals", "not_starts_with", "starts_with"} {
+		if v, ok := tfMap[operator].([]interface{}); ok && len(v) > 0 {
+			operators = append(operators, operator)
+		}
+	}
+
+	if len(operators) == 0 {
+		return fmt.Errorf("field_selector for field %q must specify at least one of ends_with, equals, not_ends_with, not_equals, not_starts_with or starts_with", field)
+	}
+
+	switch field {
+	case fieldEventCategory, fieldReadOnly, fieldResourcesType:
+		for _, operator := range operators {
+			if operator != "equals" {
+				return fmt.Errorf("field_selector for field %q supports only the equals operator, got %s", field, operator)
+			}
+		}
+	}
+
+	var allowedValues []string
+
+	switch field {
+	case fieldEventCategory:
+		allowedValues = eventCategory_Values()
+	case fieldReadOnly:
+		allowedValues = []string{"true", "false"}
+	}
+
+	if allowedValues != nil {
+		for _, v := range tfMap["equals"].([]interface{}) {
+			value, ok := v.(string)
+
+			// Unknown values are validated by the API.
+			if !ok || value == "" {
+				continue
+			}
+
+			valid := false
+
+			for _, allowedValue := range allowedValues {
+				if value == allowedValue {
+					valid = true
+					break
+				}
+			}
+
+			if !valid {
+				return fmt.Errorf("field_selector for field %q: expected equals value to be one of %q, got %s", field, allowedValues, value)
+			}
+		}
+	}
+
+	return nil
+}
+
 func flattenAdvancedEventSelector(configured []*cloudtrail.AdvancedEventSelector) []map[string]interface{} {
 	advancedEventSelectors := make([]map[string]interface{}, 0, len(configured))
 
@@ -19,21 +19,22 @@ import (
 func TestAccCloudTrail_serial(t *testing.T) {
 	testCases := map[string]map[string]func(t *testing.T){
 		"Trail": {
-			"basic":                 testAcc_basic,
-			"cloudwatch":            testAcc_cloudWatch,
-			"enableLogging":         testAcc_enableLogging,
-			"globalServiceEvents":   testAcc_globalServiceEvents,
-			"multiRegion":           testAcc_multiRegion,
-			"organization":          testAcc_organization,
-			"logValidation":         testAcc_logValidation,
-			"kmsKey":                testAcc_kmsKey,
-			"tags":                  testAcc_tags,
-			"eventSelector":         testAcc_eventSelector,
-			"eventSelectorDynamoDB": testAcc_eventSelectorDynamoDB,
-			"eventSelectorExclude":  testAcc_eventSelectorExclude,
-			"insightSelector":       testAcc_insightSelector,
-			"advancedEventSelector": testAcc_advanced_event_selector,
-			"disappears":            testAcc_disappears,
+			"basic":                                testAcc_basic,
+			"cloudwatch":                           testAcc_cloudWatch,
+			"enableLogging":                        testAcc_enableLogging,
+			"globalServiceEvents":                  testAcc_globalServiceEvents,
+			"multiRegion":                          testAcc_multiRegion,
+			"organization":                         testAcc_organization,
+			"logValidation":                        testAcc_logValidation,
+			"kmsKey":                               testAcc_kmsKey,
+			"tags":                                 testAcc_tags,
+			"eventSelector":                        testAcc_eventSelector,
+			"eventSelectorDynamoDB":                testAcc_eventSelectorDynamoDB,
+			"eventSelectorExclude":                 testAcc_eventSelectorExclude,
+			"insightSelector":                      testAcc_insightSelector,
+			"advancedEventSelector":                testAcc_advanced_event_selector,
+			"advancedEventSelectorInvalidOperator": testAcc_advancedEventSelectorInvalidOperator,
+			"disappears":                           testAcc_disappears,
 		},
 	}
 
@@ -723,6 +724,31 @@ func testAcc_advanced_event_selector(t *testing.T) {
 	})
 }
 
+func testAcc_advancedEventSelectorInvalidOperator(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudtrail.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccConfig_advancedEventSelectorOperator(rName, "resources.type", "starts_with", "AWS::S3::"),
+				ExpectError: regexp.MustCompile(`supports only the equals operator`),
+			},
+			{
+				Config:      testAccConfig_advancedEventSelectorOperator(rName, "readOnly", "equals", "yes"),
+				ExpectError: regexp.MustCompile(`expected equals value to be one of`),
+			},
+			{
+				Config:      testAccConfig_advancedEventSelectorOperator(rName, "eventCategory", "not_equals", "Management"),
+				ExpectError: regexp.MustCompile(`supports only the equals operator`),
+			},
+		},
+	})
+}
+
 func testAcc_disappears(t *testing.T) {
 	var trail cloudtrail.Trail
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
@@ -1537,3 +1563,24 @@ resource "aws_s3_bucket" "test2" {
 }
 `, rName)
 }
+
+func testAccConfig_advancedEventSelectorOperator(rName, field, operator, value string) string {
+	return fmt.Sprintf(`
+resource "aws_cloudtrail" "test" {
+  name           = %[1]q
+  s3_bucket_name = %[1]q
+
+  advanced_event_selector {
+    field_selector {
+      field  = "eventCategory"
+      equals = ["Data"]
+    }
+
+    field_selector {
+      field = %[2]q
+      %[3]s = [%[4]q]
+    }
+  }
+}
+`, rName, field, operator, value)
+}
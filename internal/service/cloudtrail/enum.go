@@ -33,3 +33,15 @@ func field_Values() []string {
 		fieldResourcesType,
 	}
 }
+
+const (
+	eventCategoryData       = "Data"
+	eventCategoryManagement = "Management"
+)
+
+func eventCategory_Values() []string {
+	return []string{
+		eventCategoryData,
+		eventCategoryManagement,
+	}
+}
@@ -17,6 +17,12 @@ const (
 	// ListLambdaFunctionsMaxResults Valid Range: Minimum value of 1. Maximum value of 25.
 	//https://docs.aws.amazon.com/connect/latest/APIReference/API_ListLambdaFunctions.html
 	ListLambdaFunctionsMaxResults = 25
+	// ListQueueQuickConnectsMaxResults Valid Range: Minimum value of 1. Maximum value of 100.
+	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListQueueQuickConnects.html
+	ListQueueQuickConnectsMaxResults = 60
+	// ListRoutingProfileQueuesMaxResults Valid Range: Minimum value of 1. Maximum value of 100.
+	// https://docs.aws.amazon.com/connect/latest/APIReference/API_ListRoutingProfileQueues.html
+	ListRoutingProfileQueuesMaxResults = 60
 )
 
 func InstanceAttributeMapping() map[string]string {
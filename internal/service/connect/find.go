@@ -105,3 +105,81 @@ func FindLambdaFunctionAssociationByArnWithContext(ctx context.Context, conn *co
 
 	return result, nil
 }
+
+func FindQueueQuickConnectIDs(ctx context.Context, conn *connect.Connect, instanceID, queueID string) ([]*string, error) {
+	var result []*string
+
+	input := &connect.ListQueueQuickConnectsInput{
+		InstanceId: aws.String(instanceID),
+		MaxResults: aws.Int64(ListQueueQuickConnectsMaxResults),
+		QueueId:    aws.String(queueID),
+	}
+
+	err := conn.ListQueueQuickConnectsPagesWithContext(ctx, input, func(page *connect.ListQueueQuickConnectsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, qc := range page.QuickConnectSummaryList {
+			if qc == nil {
+				continue
+			}
+
+			result = append(result, qc.Id)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func FindRoutingProfileQueueConfigSummaries(ctx context.Context, conn *connect.Connect, instanceID, routingProfileID string) ([]*connect.RoutingProfileQueueConfigSummary, error) {
+	var result []*connect.RoutingProfileQueueConfigSummary
+
+	input := &connect.ListRoutingProfileQueuesInput{
+		InstanceId:       aws.String(instanceID),
+		MaxResults:       aws.Int64(ListRoutingProfileQueuesMaxResults),
+		RoutingProfileId: aws.String(routingProfileID),
+	}
+
+	err := conn.ListRoutingProfileQueuesPagesWithContext(ctx, input, func(page *connect.ListRoutingProfileQueuesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, qc := range page.RoutingProfileQueueConfigSummaryList {
+			if qc == nil {
+				continue
+			}
+
+			result = append(result, qc)
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
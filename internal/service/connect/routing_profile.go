@@ -0,0 +1,492 @@
+package connect
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// The API accepts at most 10 queue configs per Create, Associate and Update call.
+const routingProfileQueueConfigsBatchSize = 10
+
+func ResourceRoutingProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceRoutingProfileCreate,
+		ReadContext:   resourceRoutingProfileRead,
+		UpdateContext: resourceRoutingProfileUpdate,
+		// Routing profiles do not support deletion today. NoOp the Delete method.
+		// Users can rename their routing profiles manually if they want.
+		DeleteContext: schema.NoopContext,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: verify.SetTagsDiff,
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_outbound_queue_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 250),
+			},
+			"instance_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"media_concurrencies": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.Channel_Values(), false),
+						},
+						"concurrency": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 10),
+						},
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 127),
+			},
+			"queue_configs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"channel": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(connect.Channel_Values(), false),
+						},
+						"delay": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 9999),
+						},
+						"priority": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 99),
+						},
+						"queue_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"routing_profile_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceRoutingProfileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	instanceID := d.Get("instance_id").(string)
+	name := d.Get("name").(string)
+
+	input := &connect.CreateRoutingProfileInput{
+		DefaultOutboundQueueId: aws.String(d.Get("default_outbound_queue_id").(string)),
+		Description:            aws.String(d.Get("description").(string)),
+		InstanceId:             aws.String(instanceID),
+		MediaConcurrencies:     expandRoutingProfileMediaConcurrencies(d.Get("media_concurrencies").(*schema.Set).List()),
+		Name:                   aws.String(name),
+	}
+
+	queueConfigs := expandRoutingProfileQueueConfigs(d.Get("queue_configs").(*schema.Set).List())
+
+	if len(queueConfigs) > routingProfileQueueConfigsBatchSize {
+		input.QueueConfigs = queueConfigs[:routingProfileQueueConfigsBatchSize]
+		queueConfigs = queueConfigs[routingProfileQueueConfigsBatchSize:]
+	} else {
+		input.QueueConfigs = queueConfigs
+		queueConfigs = nil
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating Connect Routing Profile %s", input)
+	output, err := conn.CreateRoutingProfileWithContext(ctx, input)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Routing Profile (%s): %w", name, err))
+	}
+
+	if output == nil {
+		return diag.FromErr(fmt.Errorf("error creating Connect Routing Profile (%s): empty output", name))
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s", instanceID, aws.StringValue(output.RoutingProfileId)))
+
+	if err := associateRoutingProfileQueues(ctx, conn, instanceID, aws.StringValue(output.RoutingProfileId), queueConfigs); err != nil {
+		return diag.FromErr(fmt.Errorf("error associating Connect Routing Profile (%s) queues: %w", d.Id(), err))
+	}
+
+	return resourceRoutingProfileRead(ctx, d, meta)
+}
+
+func resourceRoutingProfileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	instanceID, routingProfileID, err := RoutingProfileParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	resp, err := conn.DescribeRoutingProfileWithContext(ctx, &connect.DescribeRoutingProfileInput{
+		InstanceId:       aws.String(instanceID),
+		RoutingProfileId: aws.String(routingProfileID),
+	})
+
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+		log.Printf("[WARN] Connect Routing Profile (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect Routing Profile (%s): %w", d.Id(), err))
+	}
+
+	if resp == nil || resp.RoutingProfile == nil {
+		return diag.FromErr(fmt.Errorf("error getting Connect Routing Profile (%s): empty response", d.Id()))
+	}
+
+	d.Set("arn", resp.RoutingProfile.RoutingProfileArn)
+	d.Set("default_outbound_queue_id", resp.RoutingProfile.DefaultOutboundQueueId)
+	d.Set("description", resp.RoutingProfile.Description)
+	d.Set("instance_id", instanceID)
+	d.Set("name", resp.RoutingProfile.Name)
+	d.Set("routing_profile_id", resp.RoutingProfile.RoutingProfileId)
+
+	if err := d.Set("media_concurrencies", flattenRoutingProfileMediaConcurrencies(resp.RoutingProfile.MediaConcurrencies)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting media_concurrencies: %w", err))
+	}
+
+	queueConfigs, err := FindRoutingProfileQueueConfigSummaries(ctx, conn, instanceID, routingProfileID)
+
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error listing Connect Routing Profile (%s) queues: %w", d.Id(), err))
+	}
+
+	if err := d.Set("queue_configs", flattenRoutingProfileQueueConfigSummaries(queueConfigs)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting queue_configs: %w", err))
+	}
+
+	tags := KeyValueTags(resp.RoutingProfile.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags: %w", err))
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting tags_all: %w", err))
+	}
+
+	return nil
+}
+
+func resourceRoutingProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).ConnectConn
+
+	instanceID, routingProfileID, err := RoutingProfileParseID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description") {
+		_, err = conn.UpdateRoutingProfileNameWithContext(ctx, &connect.UpdateRoutingProfileNameInput{
+			Description:      aws.String(d.Get("description").(string)),
+			InstanceId:       aws.String(instanceID),
+			Name:             aws.String(d.Get("name").(string)),
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Routing Profile (%s) name: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("default_outbound_queue_id") {
+		_, err = conn.UpdateRoutingProfileDefaultOutboundQueueWithContext(ctx, &connect.UpdateRoutingProfileDefaultOutboundQueueInput{
+			DefaultOutboundQueueId: aws.String(d.Get("default_outbound_queue_id").(string)),
+			InstanceId:             aws.String(instanceID),
+			RoutingProfileId:       aws.String(routingProfileID),
+		})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Routing Profile (%s) default outbound queue: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("media_concurrencies") {
+		_, err = conn.UpdateRoutingProfileConcurrencyWithContext(ctx, &connect.UpdateRoutingProfileConcurrencyInput{
+			InstanceId:         aws.String(instanceID),
+			MediaConcurrencies: expandRoutingProfileMediaConcurrencies(d.Get("media_concurrencies").(*schema.Set).List()),
+			RoutingProfileId:   aws.String(routingProfileID),
+		})
+
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Routing Profile (%s) media concurrencies: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("queue_configs") {
+		o, n := d.GetChange("queue_configs")
+
+		if err := updateRoutingProfileQueues(ctx, conn, instanceID, routingProfileID, o.(*schema.Set).List(), n.(*schema.Set).List()); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating Connect Routing Profile (%s) queues: %w", d.Id(), err))
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.FromErr(fmt.Errorf("error updating tags: %w", err))
+		}
+	}
+
+	return resourceRoutingProfileRead(ctx, d, meta)
+}
+
+// updateRoutingProfileQueues reconciles the queues associated with a routing profile.
+// Queue configs are keyed by channel and queue ID: keys only in the old set are disassociated,
+// keys only in the new set are associated and keys in both with a changed delay or priority are updated.
+func updateRoutingProfileQueues(ctx context.Context, conn *connect.Connect, instanceID, routingProfileID string, o, n []interface{}) error {
+	oldConfigs := make(map[string]*connect.RoutingProfileQueueConfig)
+	for _, v := range expandRoutingProfileQueueConfigs(o) {
+		oldConfigs[routingProfileQueueReferenceKey(v.QueueReference)] = v
+	}
+
+	newConfigs := make(map[string]*connect.RoutingProfileQueueConfig)
+	for _, v := range expandRoutingProfileQueueConfigs(n) {
+		newConfigs[routingProfileQueueReferenceKey(v.QueueReference)] = v
+	}
+
+	var del []*connect.RoutingProfileQueueReference
+	var add, update []*connect.RoutingProfileQueueConfig
+
+	for k, v := range oldConfigs {
+		if _, ok := newConfigs[k]; !ok {
+			del = append(del, v.QueueReference)
+		}
+	}
+
+	for k, v := range newConfigs {
+		old, ok := oldConfigs[k]
+
+		if !ok {
+			add = append(add, v)
+			continue
+		}
+
+		if aws.Int64Value(old.Delay) != aws.Int64Value(v.Delay) || aws.Int64Value(old.Priority) != aws.Int64Value(v.Priority) {
+			update = append(update, v)
+		}
+	}
+
+	for len(del) > 0 {
+		batch := del
+		if len(batch) > routingProfileQueueConfigsBatchSize {
+			batch = batch[:routingProfileQueueConfigsBatchSize]
+		}
+		del = del[len(batch):]
+
+		_, err := conn.DisassociateRoutingProfileQueuesWithContext(ctx, &connect.DisassociateRoutingProfileQueuesInput{
+			InstanceId:       aws.String(instanceID),
+			QueueReferences:  batch,
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error disassociating queues: %w", err)
+		}
+	}
+
+	if err := associateRoutingProfileQueues(ctx, conn, instanceID, routingProfileID, add); err != nil {
+		return err
+	}
+
+	for len(update) > 0 {
+		batch := update
+		if len(batch) > routingProfileQueueConfigsBatchSize {
+			batch = batch[:routingProfileQueueConfigsBatchSize]
+		}
+		update = update[len(batch):]
+
+		_, err := conn.UpdateRoutingProfileQueuesWithContext(ctx, &connect.UpdateRoutingProfileQueuesInput{
+			InstanceId:       aws.String(instanceID),
+			QueueConfigs:     batch,
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error updating queues: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func associateRoutingProfileQueues(ctx context.Context, conn *connect.Connect, instanceID, routingProfileID string, queueConfigs []*connect.RoutingProfileQueueConfig) error {
+	for len(queueConfigs) > 0 {
+		batch := queueConfigs
+		if len(batch) > routingProfileQueueConfigsBatchSize {
+			batch = batch[:routingProfileQueueConfigsBatchSize]
+		}
+		queueConfigs = queueConfigs[len(batch):]
+
+		_, err := conn.AssociateRoutingProfileQueuesWithContext(ctx, &connect.AssociateRoutingProfileQueuesInput{
+			InstanceId:       aws.String(instanceID),
+			QueueConfigs:     batch,
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error associating queues: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func routingProfileQueueReferenceKey(apiObject *connect.RoutingProfileQueueReference) string {
+	return fmt.Sprintf("%s:%s", aws.StringValue(apiObject.Channel), aws.StringValue(apiObject.QueueId))
+}
+
+func expandRoutingProfileMediaConcurrencies(tfList []interface{}) []*connect.MediaConcurrency {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*connect.MediaConcurrency
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &connect.MediaConcurrency{
+			Channel:     aws.String(tfMap["channel"].(string)),
+			Concurrency: aws.Int64(int64(tfMap["concurrency"].(int))),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenRoutingProfileMediaConcurrencies(apiObjects []*connect.MediaConcurrency) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"channel":     aws.StringValue(apiObject.Channel),
+			"concurrency": aws.Int64Value(apiObject.Concurrency),
+		})
+	}
+
+	return tfList
+}
+
+func expandRoutingProfileQueueConfigs(tfList []interface{}) []*connect.RoutingProfileQueueConfig {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	var apiObjects []*connect.RoutingProfileQueueConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &connect.RoutingProfileQueueConfig{
+			Delay:    aws.Int64(int64(tfMap["delay"].(int))),
+			Priority: aws.Int64(int64(tfMap["priority"].(int))),
+			QueueReference: &connect.RoutingProfileQueueReference{
+				Channel: aws.String(tfMap["channel"].(string)),
+				QueueId: aws.String(tfMap["queue_id"].(string)),
+			},
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenRoutingProfileQueueConfigSummaries(apiObjects []*connect.RoutingProfileQueueConfigSummary) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"channel":  aws.StringValue(apiObject.Channel),
+			"delay":    aws.Int64Value(apiObject.Delay),
+			"priority": aws.Int64Value(apiObject.Priority),
+			"queue_id": aws.StringValue(apiObject.QueueId),
+		})
+	}
+
+	return tfList
+}
+
+func RoutingProfileParseID(id string) (string, string, error) {
+	parts := strings.SplitN(id, ":", 2)
+
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("unexpected format of ID (%s), expected instanceID:routingProfileID", id)
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -0,0 +1,267 @@
+package connect_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/connect"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfconnect "github.com/hashicorp/terraform-provider-aws/internal/service/connect"
+)
+
+// Serialized acceptance tests due to Connect account limits (max 2 parallel tests)
+func TestAccConnectRoutingProfile_serial(t *testing.T) {
+	testCases := map[string]func(t *testing.T){
+		"basic":        testAccRoutingProfile_basic,
+		"queueConfigs": testAccRoutingProfile_queueConfigs,
+	}
+
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			tc(t)
+		})
+	}
+}
+
+func testAccRoutingProfile_basic(t *testing.T) {
+	var v connect.DescribeRoutingProfileOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_routing_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoutingProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingProfileBasicConfig(rName, rName2, "Created", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoutingProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "routing_profile_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "instance_id", "aws_connect_instance.test", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "default_outbound_queue_id", "aws_connect_queue.test", "queue_id"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName2),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created"),
+					resource.TestCheckResourceAttr(resourceName, "media_concurrencies.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "media_concurrencies.*", map[string]string{
+						"channel":     connect.ChannelVoice,
+						"concurrency": "1",
+					}),
+					resource.TestCheckResourceAttr(resourceName, "queue_configs.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoutingProfileBasicConfig(rName, rName2, "Updated", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRoutingProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "media_concurrencies.*", map[string]string{
+						"channel":     connect.ChannelChat,
+						"concurrency": "2",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccRoutingProfile_queueConfigs(t *testing.T) {
+	var v connect.DescribeRoutingProfileOutput
+	rName := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	rName2 := sdkacctest.RandomWithPrefix("resource-test-terraform")
+	resourceName := "aws_connect_routing_profile.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, connect.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoutingProfileDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoutingProfileQueueConfigsConfig(rName, rName2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoutingProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "queue_configs.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "queue_configs.*", map[string]string{
+						"channel":  connect.ChannelVoice,
+						"delay":    "1",
+						"priority": "1",
+					}),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "queue_configs.*.queue_id", "aws_connect_queue.test", "queue_id"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRoutingProfileQueueConfigsConfig(rName, rName2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoutingProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "queue_configs.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "queue_configs.*", map[string]string{
+						"channel":  connect.ChannelVoice,
+						"delay":    "2",
+						"priority": "2",
+					}),
+				),
+			},
+			{
+				Config: testAccRoutingProfileBasicConfig(rName, rName2, "Removed", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoutingProfileExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "queue_configs.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckRoutingProfileExists(resourceName string, v *connect.DescribeRoutingProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Connect Routing Profile not found: %s", resourceName)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("Connect Routing Profile ID not set")
+		}
+
+		instanceID, routingProfileID, err := tfconnect.RoutingProfileParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+
+		output, err := conn.DescribeRoutingProfile(&connect.DescribeRoutingProfileInput{
+			InstanceId:       aws.String(instanceID),
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+// Routing profiles can't be deleted; they are removed along with the Connect instance.
+func testAccCheckRoutingProfileDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ConnectConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_connect_routing_profile" {
+			continue
+		}
+
+		instanceID, routingProfileID, err := tfconnect.RoutingProfileParseID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = conn.DescribeRoutingProfile(&connect.DescribeRoutingProfileInput{
+			InstanceId:       aws.String(instanceID),
+			RoutingProfileId: aws.String(routingProfileID),
+		})
+
+		if tfawserr.ErrCodeEquals(err, connect.ErrCodeResourceNotFoundException) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("Connect Routing Profile %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccRoutingProfileBaseConfig(rName string) string {
+	return acctest.ConfigCompose(
+		testAccQueueBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_queue" "test" {
+  instance_id           = aws_connect_instance.test.id
+  name                  = %[1]q
+  hours_of_operation_id = aws_connect_hours_of_operation.test.hours_of_operation_id
+}
+`, rName))
+}
+
+func testAccRoutingProfileBasicConfig(rName, rName2, label string, chatConcurrency int) string {
+	return acctest.ConfigCompose(
+		testAccRoutingProfileBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_routing_profile" "test" {
+  instance_id               = aws_connect_instance.test.id
+  name                      = %[1]q
+  description               = %[2]q
+  default_outbound_queue_id = aws_connect_queue.test.queue_id
+
+  media_concurrencies {
+    channel     = "VOICE"
+    concurrency = 1
+  }
+
+  media_concurrencies {
+    channel     = "CHAT"
+    concurrency = %[3]d
+  }
+
+  tags = {
+    "Name" = "Test Routing Profile",
+  }
+}
+`, rName2, label, chatConcurrency))
+}
+
+func testAccRoutingProfileQueueConfigsConfig(rName, rName2 string, n int) string {
+	return acctest.ConfigCompose(
+		testAccRoutingProfileBaseConfig(rName),
+		fmt.Sprintf(`
+resource "aws_connect_routing_profile" "test" {
+  instance_id               = aws_connect_instance.test.id
+  name                      = %[1]q
+  description               = "Queue configs"
+  default_outbound_queue_id = aws_connect_queue.test.queue_id
+
+  media_concurrencies {
+    channel     = "VOICE"
+    concurrency = 1
+  }
+
+  queue_configs {
+    channel  = "VOICE"
+    delay    = %[2]d
+    priority = %[2]d
+    queue_id = aws_connect_queue.test.queue_id
+  }
+}
+`, rName2, n))
+}
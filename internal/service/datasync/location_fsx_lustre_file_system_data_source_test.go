@@ -0,0 +1,48 @@
+package datasync_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/datasync"
+	"github.com/aws/aws-sdk-go/service/fsx"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccDataSyncLocationFSxLustreFileSystemDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_datasync_location_fsx_lustre_file_system.test"
+	resourceName := "aws_datasync_location_fsx_lustre_file_system.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(fsx.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, datasync.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckLocationFSxLustreDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLocationFSxLustreFileSystemDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "creation_time", resourceName, "creation_time"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "security_group_arns.#", resourceName, "security_group_arns.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "subdirectory", resourceName, "subdirectory"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.key1", resourceName, "tags.key1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "uri", resourceName, "uri"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLocationFSxLustreFileSystemDataSourceConfig() string {
+	return acctest.ConfigCompose(testAccLocationFSxLustreTags1Config("key1", "value1"), `
+data "aws_datasync_location_fsx_lustre_file_system" "test" {
+  arn = aws_datasync_location_fsx_lustre_file_system.test.arn
+}
+`)
+}
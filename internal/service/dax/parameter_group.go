@@ -1,6 +1,7 @@
 package dax
 
 import (
+	"fmt"
 	"log"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -99,9 +100,9 @@ func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error
 
 	pg := resp.ParameterGroups[0]
 
-	paramresp, err := conn.DescribeParameters(&dax.DescribeParametersInput{
-		ParameterGroupName: aws.String(d.Id()),
-	})
+	userDefinedParameters := createUserDefinedParameterMap(d)
+
+	parameters, err := listParameterGroupParameters(conn, d.Id(), userDefinedParameters)
 	if err != nil {
 		if tfawserr.ErrMessageContains(err, dax.ErrCodeParameterGroupNotFoundFault, "") {
 			log.Printf("[WARN] DAX ParameterGroup %q not found, removing from state", d.Id())
@@ -118,7 +119,7 @@ func resourceParameterGroupRead(d *schema.ResourceData, meta interface{}) error
 		*desc = ""
 	}
 	d.Set("description", desc)
-	d.Set("parameters", flattenDAXParameterGroupParameters(paramresp.Parameters))
+	d.Set("parameters", flattenDAXParameterGroupParameters(parameters))
 	return nil
 }
 
@@ -160,3 +161,111 @@ func resourceParameterGroupDelete(d *schema.ResourceData, meta interface{}) erro
 
 	return nil
 }
+
+// defaultParameterGroupName is the name of the DAX default parameter group.
+const defaultParameterGroupName = "default.dax1.0"
+
+// parameterGroupParametersQueryFunc returns the parameters in the DAX
+// parameter group with the given name.
+type parameterGroupParametersQueryFunc func(parameterGroupName string) ([]*dax.Parameter, error)
+
+// listParameterGroupParameters returns the user-defined DAX parameters in the
+// group with the given name.
+//
+// Parameters given in userDefined will be returned even if the value is equal
+// to the default. Callers pass the parameters currently held in state, so that
+// a parameter reset to its default outside of Terraform is still read back and
+// shows up as a difference on the next plan.
+func listParameterGroupParameters(conn *dax.DAX, name string, userDefined map[string]string) ([]*dax.Parameter, error) {
+	query := func(parameterGroupName string) ([]*dax.Parameter, error) {
+		input := &dax.DescribeParametersInput{
+			ParameterGroupName: aws.String(parameterGroupName),
+		}
+
+		var parameters []*dax.Parameter
+
+		for {
+			output, err := conn.DescribeParameters(input)
+
+			if err != nil {
+				return nil, err
+			}
+
+			parameters = append(parameters, output.Parameters...)
+
+			if aws.StringValue(output.NextToken) == "" {
+				break
+			}
+
+			input.NextToken = output.NextToken
+		}
+
+		return parameters, nil
+	}
+
+	return listParameterGroupParametersWithQuery(query, name, userDefined)
+}
+
+// listParameterGroupParametersWithQuery implements listParameterGroupParameters
+// on top of the given query function.
+func listParameterGroupParametersWithQuery(query parameterGroupParametersQueryFunc, name string, userDefined map[string]string) ([]*dax.Parameter, error) {
+	defaults, err := query(defaultParameterGroupName)
+
+	if tfawserr.ErrCodeEquals(err, dax.ErrCodeParameterGroupNotFoundFault) {
+		// Treat every default value as empty rather than failing the read.
+		log.Printf("[WARN] DAX default Parameter Group (%s) not found, treating all default values as empty", defaultParameterGroupName)
+		defaults = nil
+	} else if err != nil {
+		return nil, fmt.Errorf("list defaults from %s: %w", defaultParameterGroupName, err)
+	}
+
+	defaultValueByName := map[string]string{}
+	for _, defaultPV := range defaults {
+		defaultValueByName[aws.StringValue(defaultPV.ParameterName)] = aws.StringValue(defaultPV.ParameterValue)
+	}
+
+	current, err := query(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*dax.Parameter
+
+	for _, parameter := range current {
+		name := aws.StringValue(parameter.ParameterName)
+		currentValue := aws.StringValue(parameter.ParameterValue)
+		defaultValue := defaultValueByName[name]
+		_, isUserDefined := userDefined[name]
+
+		if currentValue != defaultValue || isUserDefined {
+			result = append(result, parameter)
+		}
+	}
+
+	return result, nil
+}
+
+func createUserDefinedParameterMap(d *schema.ResourceData) map[string]string {
+	result := map[string]string{}
+
+	for _, param := range d.Get("parameters").(*schema.Set).List() {
+		m, ok := param.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, ok := m["name"].(string)
+		if !ok || name == "" {
+			continue
+		}
+
+		value, ok := m["value"].(string)
+		if !ok {
+			continue
+		}
+
+		result[name] = value
+	}
+
+	return result
+}
@@ -0,0 +1,93 @@
+package dax
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dax"
+)
+
+func Test_listParameterGroupParametersWithQuery(t *testing.T) {
+	current := []*dax.Parameter{
+		{ParameterName: aws.String("query-ttl-millis"), ParameterValue: aws.String("100000")},
+		{ParameterName: aws.String("record-ttl-millis"), ParameterValue: aws.String("300000")},
+	}
+
+	defaults := []*dax.Parameter{
+		{ParameterName: aws.String("query-ttl-millis"), ParameterValue: aws.String("300000")},
+		{ParameterName: aws.String("record-ttl-millis"), ParameterValue: aws.String("300000")},
+	}
+
+	cases := []struct {
+		Name        string
+		Defaults    []*dax.Parameter
+		DefaultsErr error
+		UserDefined map[string]string
+		Expected    []*dax.Parameter
+		ExpectedErr bool
+	}{
+		{
+			Name:        "Defaults omitted",
+			Defaults:    defaults,
+			UserDefined: map[string]string{},
+			Expected: []*dax.Parameter{
+				{ParameterName: aws.String("query-ttl-millis"), ParameterValue: aws.String("100000")},
+			},
+		},
+		{
+			Name:        "User-defined defaults kept",
+			Defaults:    defaults,
+			UserDefined: map[string]string{"record-ttl-millis": "300000"},
+			Expected: []*dax.Parameter{
+				{ParameterName: aws.String("query-ttl-millis"), ParameterValue: aws.String("100000")},
+				{ParameterName: aws.String("record-ttl-millis"), ParameterValue: aws.String("300000")},
+			},
+		},
+		{
+			Name:        "Default parameter group not found",
+			DefaultsErr: awserr.New(dax.ErrCodeParameterGroupNotFoundFault, "ParameterGroup not found", nil),
+			UserDefined: map[string]string{},
+			Expected:    current,
+		},
+		{
+			Name:        "Default parameter group error",
+			DefaultsErr: awserr.New(dax.ErrCodeInvalidParameterValueException, "invalid", nil),
+			UserDefined: map[string]string{},
+			ExpectedErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		query := func(parameterGroupName string) ([]*dax.Parameter, error) {
+			switch parameterGroupName {
+			case "default.dax1.0":
+				return tc.Defaults, tc.DefaultsErr
+			case "test":
+				return current, nil
+			}
+
+			t.Fatalf("Case %q: unexpected parameter group name %q", tc.Name, parameterGroupName)
+			return nil, nil
+		}
+
+		got, err := listParameterGroupParametersWithQuery(query, "test", tc.UserDefined)
+
+		if tc.ExpectedErr {
+			if err == nil {
+				t.Errorf("Case %q: expected error, got none", tc.Name)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Case %q: unexpected error: %s", tc.Name, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("Case %q: parameters did not match\n%#v\n\nGot:\n%#v", tc.Name, tc.Expected, got)
+		}
+	}
+}
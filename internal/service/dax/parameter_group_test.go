@@ -26,16 +26,64 @@ func TestAccDAXParameterGroup_basic(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccDaxParameterGroupConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDaxParameterGroupConfig_parameters(rName),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckParameterGroupExists(resourceName),
 					resource.TestCheckResourceAttr(resourceName, "parameters.#", "2"),
 				),
 			},
+		},
+	})
+}
+
+func TestAccDAXParameterGroup_parameters(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_dax_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dax.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDaxParameterGroupConfig_parameter(rName, "query-ttl-millis", "100000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameters.*", map[string]string{
+						"name":  "query-ttl-millis",
+						"value": "100000",
+					}),
+				),
+			},
 			{
 				ResourceName:      resourceName,
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				Config: testAccDaxParameterGroupConfig_parameter(rName, "query-ttl-millis", "200000"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameters.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameters.*", map[string]string{
+						"name":  "query-ttl-millis",
+						"value": "200000",
+					}),
+				),
+			},
 			{
 				Config: testAccDaxParameterGroupConfig_parameters(rName),
 				Check: resource.ComposeTestCheckFunc(
@@ -110,3 +158,16 @@ resource "aws_dax_parameter_group" "test" {
 }
 `, rName)
 }
+
+func testAccDaxParameterGroupConfig_parameter(rName, name, value string) string {
+	return fmt.Sprintf(`
+resource "aws_dax_parameter_group" "test" {
+  name = %[1]q
+
+  parameters {
+    name  = %[2]q
+    value = %[3]q
+  }
+}
+`, rName, name, value)
+}
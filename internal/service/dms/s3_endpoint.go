@@ -0,0 +1,588 @@
+package dms
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceS3Endpoint() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceS3EndpointCreate,
+		Read:   resourceS3EndpointRead,
+		Update: resourceS3EndpointUpdate,
+		Delete: resourceS3EndpointDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"add_column_name": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"bucket_folder": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"bucket_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"canned_acl_for_objects": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dms.CannedAclForObjectsValue_Values(), true),
+				StateFunc: func(v interface{}) string {
+					return strings.ToLower(v.(string))
+				},
+			},
+			"cdc_inserts_and_updates": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"cdc_inserts_only": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"cdc_max_batch_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"cdc_min_file_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"cdc_path": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"certificate_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"compression_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3SettingsCompressionTypeNone,
+				ValidateFunc: validation.StringInSlice(s3SettingsCompressionType_Values(), false),
+			},
+			"csv_delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  ",",
+			},
+			"csv_no_sup_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"csv_null_value": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"csv_row_delimiter": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "\\n",
+			},
+			"data_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      dms.DataFormatValueCsv,
+				ValidateFunc: validation.StringInSlice(dms.DataFormatValue_Values(), false),
+			},
+			"data_page_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"date_partition_delimiter": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dms.DatePartitionDelimiterValue_Values(), true),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+			"date_partition_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"date_partition_sequence": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dms.DatePartitionSequenceValue_Values(), true),
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.EqualFold(old, new)
+				},
+			},
+			"dict_page_size_limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"enable_statistics": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"encoding_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dms.EncodingTypeValue_Values(), false),
+			},
+			"encryption_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      s3SettingsEncryptionModeSseS3,
+				ValidateFunc: validation.StringInSlice(s3SettingsEncryptionMode_Values(), false),
+			},
+			"endpoint_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"endpoint_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validEndpointID,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice(dms.ReplicationEndpointTypeValue_Values(), false),
+			},
+			"engine_display_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"external_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"external_table_definition": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"ignore_header_rows": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+			"include_op_for_full_load": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"kms_key_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"max_file_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 1048576),
+			},
+			"parquet_timestamp_in_millisecond": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"parquet_version": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dms.ParquetVersionValue_Values(), false),
+			},
+			"preserve_transactions": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+			"rfc_4180": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+			"row_group_length": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"server_side_encryption_kms_key_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"service_access_role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"ssl_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(dms.DmsSslModeValue_Values(), false),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"timestamp_column_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"use_csv_no_sup_value": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceS3EndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DMSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	endpointID := d.Get("endpoint_id").(string)
+	input := &dms.CreateEndpointInput{
+		EndpointIdentifier: aws.String(endpointID),
+		EndpointType:       aws.String(d.Get("endpoint_type").(string)),
+		EngineName:         aws.String(engineNameS3),
+		S3Settings:         expandS3EndpointSettings(d),
+		Tags:               Tags(tags.IgnoreAWS()),
+	}
+
+	if v, ok := d.GetOk("certificate_arn"); ok {
+		input.CertificateArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("kms_key_arn"); ok {
+		input.KmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("ssl_mode"); ok {
+		input.SslMode = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating DMS S3 Endpoint: %s", input)
+	err := resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, err := conn.CreateEndpoint(input)
+
+		if tfawserr.ErrCodeEquals(err, dms.ErrCodeAccessDeniedFault) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		_, err = conn.CreateEndpoint(input)
+	}
+
+	if err != nil {
+		return fmt.Errorf("error creating DMS S3 Endpoint (%s): %w", endpointID, err)
+	}
+
+	d.SetId(endpointID)
+
+	return resourceS3EndpointRead(d, meta)
+}
+
+func resourceS3EndpointRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DMSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	endpoint, err := FindEndpointByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] DMS S3 Endpoint (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading DMS S3 Endpoint (%s): %w", d.Id(), err)
+	}
+
+	if engineName := aws.StringValue(endpoint.EngineName); engineName != engineNameS3 {
+		return fmt.Errorf("DMS Endpoint (%s) has engine name %q, expected %q", d.Id(), engineName, engineNameS3)
+	}
+
+	d.Set("certificate_arn", endpoint.CertificateArn)
+	d.Set("endpoint_arn", endpoint.EndpointArn)
+	d.Set("endpoint_id", endpoint.EndpointIdentifier)
+	// For some reason the AWS API only accepts lowercase type but returns it as uppercase
+	d.Set("endpoint_type", strings.ToLower(aws.StringValue(endpoint.EndpointType)))
+	d.Set("engine_display_name", endpoint.EngineDisplayName)
+	d.Set("external_id", endpoint.ExternalId)
+	d.Set("kms_key_arn", endpoint.KmsKeyId)
+	d.Set("ssl_mode", endpoint.SslMode)
+	d.Set("status", endpoint.Status)
+
+	if s3settings := endpoint.S3Settings; s3settings != nil {
+		d.Set("add_column_name", s3settings.AddColumnName)
+		d.Set("bucket_folder", s3settings.BucketFolder)
+		d.Set("bucket_name", s3settings.BucketName)
+		d.Set("canned_acl_for_objects", strings.ToLower(aws.StringValue(s3settings.CannedAclForObjects)))
+		d.Set("cdc_inserts_and_updates", s3settings.CdcInsertsAndUpdates)
+		d.Set("cdc_inserts_only", s3settings.CdcInsertsOnly)
+		d.Set("cdc_max_batch_interval", s3settings.CdcMaxBatchInterval)
+		d.Set("cdc_min_file_size", s3settings.CdcMinFileSize)
+		d.Set("cdc_path", s3settings.CdcPath)
+		d.Set("compression_type", s3settings.CompressionType)
+		d.Set("csv_delimiter", s3settings.CsvDelimiter)
+		d.Set("csv_no_sup_value", s3settings.CsvNoSupValue)
+		d.Set("csv_null_value", s3settings.CsvNullValue)
+		d.Set("csv_row_delimiter", s3settings.CsvRowDelimiter)
+		d.Set("data_format", s3settings.DataFormat)
+		d.Set("data_page_size", s3settings.DataPageSize)
+		d.Set("date_partition_delimiter", s3settings.DatePartitionDelimiter)
+		d.Set("date_partition_enabled", s3settings.DatePartitionEnabled)
+		d.Set("date_partition_sequence", s3settings.DatePartitionSequence)
+		d.Set("dict_page_size_limit", s3settings.DictPageSizeLimit)
+		d.Set("enable_statistics", s3settings.EnableStatistics)
+		d.Set("encoding_type", s3settings.EncodingType)
+		d.Set("encryption_mode", s3settings.EncryptionMode)
+		d.Set("external_table_definition", s3settings.ExternalTableDefinition)
+		d.Set("ignore_header_rows", s3settings.IgnoreHeaderRows)
+		d.Set("include_op_for_full_load", s3settings.IncludeOpForFullLoad)
+		d.Set("max_file_size", s3settings.MaxFileSize)
+		d.Set("parquet_timestamp_in_millisecond", s3settings.ParquetTimestampInMillisecond)
+		d.Set("parquet_version", s3settings.ParquetVersion)
+		d.Set("preserve_transactions", s3settings.PreserveTransactions)
+		d.Set("rfc_4180", s3settings.Rfc4180)
+		d.Set("row_group_length", s3settings.RowGroupLength)
+		d.Set("server_side_encryption_kms_key_id", s3settings.ServerSideEncryptionKmsKeyId)
+		d.Set("service_access_role_arn", s3settings.ServiceAccessRoleArn)
+		d.Set("timestamp_column_name", s3settings.TimestampColumnName)
+		d.Set("use_csv_no_sup_value", s3settings.UseCsvNoSupValue)
+	}
+
+	tags, err := ListTags(conn, d.Get("endpoint_arn").(string))
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for DMS S3 Endpoint (%s): %w", d.Get("endpoint_arn").(string), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceS3EndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DMSConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &dms.ModifyEndpointInput{
+			EndpointArn: aws.String(d.Get("endpoint_arn").(string)),
+			EngineName:  aws.String(engineNameS3),
+			S3Settings:  expandS3EndpointSettings(d),
+		}
+
+		if d.HasChange("certificate_arn") {
+			input.CertificateArn = aws.String(d.Get("certificate_arn").(string))
+		}
+
+		if d.HasChange("endpoint_type") {
+			input.EndpointType = aws.String(d.Get("endpoint_type").(string))
+		}
+
+		if d.HasChange("ssl_mode") {
+			input.SslMode = aws.String(d.Get("ssl_mode").(string))
+		}
+
+		log.Printf("[DEBUG] Updating DMS S3 Endpoint: %s", input)
+		_, err := conn.ModifyEndpoint(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating DMS S3 Endpoint (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		arn := d.Get("endpoint_arn").(string)
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, arn, o, n); err != nil {
+			return fmt.Errorf("error updating DMS S3 Endpoint (%s) tags: %w", arn, err)
+		}
+	}
+
+	return resourceS3EndpointRead(d, meta)
+}
+
+func resourceS3EndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).DMSConn
+
+	log.Printf("[DEBUG] Deleting DMS S3 Endpoint: %s", d.Id())
+	_, err := conn.DeleteEndpoint(&dms.DeleteEndpointInput{
+		EndpointArn: aws.String(d.Get("endpoint_arn").(string)),
+	})
+
+	if tfawserr.ErrCodeEquals(err, dms.ErrCodeResourceNotFoundFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting DMS S3 Endpoint (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitEndpointDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for DMS S3 Endpoint (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandS3EndpointSettings(d *schema.ResourceData) *dms.S3Settings {
+	apiObject := &dms.S3Settings{
+		AddColumnName:                 aws.Bool(d.Get("add_column_name").(bool)),
+		BucketName:                    aws.String(d.Get("bucket_name").(string)),
+		CdcInsertsAndUpdates:          aws.Bool(d.Get("cdc_inserts_and_updates").(bool)),
+		CdcInsertsOnly:                aws.Bool(d.Get("cdc_inserts_only").(bool)),
+		CompressionType:               aws.String(d.Get("compression_type").(string)),
+		CsvDelimiter:                  aws.String(d.Get("csv_delimiter").(string)),
+		CsvRowDelimiter:               aws.String(d.Get("csv_row_delimiter").(string)),
+		DataFormat:                    aws.String(d.Get("data_format").(string)),
+		DatePartitionEnabled:          aws.Bool(d.Get("date_partition_enabled").(bool)),
+		EnableStatistics:              aws.Bool(d.Get("enable_statistics").(bool)),
+		EncryptionMode:                aws.String(d.Get("encryption_mode").(string)),
+		IgnoreHeaderRows:              aws.Int64(int64(d.Get("ignore_header_rows").(int))),
+		IncludeOpForFullLoad:          aws.Bool(d.Get("include_op_for_full_load").(bool)),
+		ParquetTimestampInMillisecond: aws.Bool(d.Get("parquet_timestamp_in_millisecond").(bool)),
+		PreserveTransactions:          aws.Bool(d.Get("preserve_transactions").(bool)),
+		Rfc4180:                       aws.Bool(d.Get("rfc_4180").(bool)),
+		ServiceAccessRoleArn:          aws.String(d.Get("service_access_role_arn").(string)),
+		UseCsvNoSupValue:              aws.Bool(d.Get("use_csv_no_sup_value").(bool)),
+	}
+
+	if v, ok := d.GetOk("bucket_folder"); ok {
+		apiObject.BucketFolder = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("canned_acl_for_objects"); ok {
+		apiObject.CannedAclForObjects = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("cdc_max_batch_interval"); ok {
+		apiObject.CdcMaxBatchInterval = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("cdc_min_file_size"); ok {
+		apiObject.CdcMinFileSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("cdc_path"); ok {
+		apiObject.CdcPath = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("csv_no_sup_value"); ok {
+		apiObject.CsvNoSupValue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("csv_null_value"); ok {
+		apiObject.CsvNullValue = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("data_page_size"); ok {
+		apiObject.DataPageSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("date_partition_delimiter"); ok {
+		apiObject.DatePartitionDelimiter = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("date_partition_sequence"); ok {
+		apiObject.DatePartitionSequence = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("dict_page_size_limit"); ok {
+		apiObject.DictPageSizeLimit = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("encoding_type"); ok {
+		apiObject.EncodingType = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("external_table_definition"); ok {
+		apiObject.ExternalTableDefinition = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("max_file_size"); ok {
+		apiObject.MaxFileSize = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("parquet_version"); ok {
+		apiObject.ParquetVersion = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("row_group_length"); ok {
+		apiObject.RowGroupLength = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("server_side_encryption_kms_key_id"); ok {
+		apiObject.ServerSideEncryptionKmsKeyId = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("timestamp_column_name"); ok {
+		apiObject.TimestampColumnName = aws.String(v.(string))
+	}
+
+	return apiObject
+}
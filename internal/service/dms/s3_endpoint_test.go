@@ -0,0 +1,344 @@
+package dms_test
+
+import (
+	"fmt"
+	"testing"
+
+	dms "github.com/aws/aws-sdk-go/service/databasemigrationservice"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdms "github.com/hashicorp/terraform-provider-aws/internal/service/dms"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccDMSS3Endpoint_basic(t *testing.T) {
+	resourceName := "aws_dms_s3_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckS3EndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3EndpointConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName),
+					resource.TestCheckResourceAttrSet(resourceName, "endpoint_arn"),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_id", rName),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_type", "target"),
+					resource.TestCheckResourceAttrPair(resourceName, "bucket_name", "aws_s3_bucket.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_folder", ""),
+					resource.TestCheckResourceAttr(resourceName, "compression_type", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "csv_delimiter", ","),
+					resource.TestCheckResourceAttr(resourceName, "csv_row_delimiter", "\\n"),
+					resource.TestCheckResourceAttr(resourceName, "data_format", "csv"),
+					resource.TestCheckResourceAttr(resourceName, "encryption_mode", "SSE_S3"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_header_rows", "0"),
+					resource.TestCheckResourceAttrPair(resourceName, "service_access_role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "use_csv_no_sup_value", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccS3EndpointConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "add_column_name", "true"),
+					resource.TestCheckResourceAttr(resourceName, "bucket_folder", "folder"),
+					resource.TestCheckResourceAttr(resourceName, "cdc_inserts_and_updates", "true"),
+					resource.TestCheckResourceAttr(resourceName, "cdc_path", "cdc/path"),
+					resource.TestCheckResourceAttr(resourceName, "compression_type", "GZIP"),
+					resource.TestCheckResourceAttr(resourceName, "csv_no_sup_value", "x"),
+					resource.TestCheckResourceAttr(resourceName, "date_partition_enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "date_partition_sequence", "YYYYMMDDHH"),
+					resource.TestCheckResourceAttr(resourceName, "include_op_for_full_load", "true"),
+					resource.TestCheckResourceAttr(resourceName, "max_file_size", "120"),
+					resource.TestCheckResourceAttr(resourceName, "preserve_transactions", "true"),
+					resource.TestCheckResourceAttr(resourceName, "timestamp_column_name", "tx_commit_time"),
+					resource.TestCheckResourceAttr(resourceName, "use_csv_no_sup_value", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDMSS3Endpoint_disappears(t *testing.T) {
+	resourceName := "aws_dms_s3_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckS3EndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3EndpointConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName),
+					acctest.CheckResourceDisappears(acctest.Provider, tfdms.ResourceS3Endpoint(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDMSS3Endpoint_sourceIgnoreHeaderRows(t *testing.T) {
+	resourceName := "aws_dms_s3_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckS3EndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3EndpointConfigSource(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "endpoint_type", "source"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_header_rows", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rfc_4180", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccDMSS3Endpoint_tags(t *testing.T) {
+	resourceName := "aws_dms_s3_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, dms.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckS3EndpointDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccS3EndpointConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccS3EndpointConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccS3EndpointConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEndpointExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckS3EndpointDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).DMSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_dms_s3_endpoint" {
+			continue
+		}
+
+		_, err := tfdms.FindEndpointByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("DMS S3 Endpoint %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccS3EndpointConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action    = "sts:AssumeRole"
+      Effect    = "Allow"
+      Principal = { Service = "dms.${data.aws_partition.current.dns_suffix}" }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Action = [
+        "s3:DeleteObject",
+        "s3:GetBucketLocation",
+        "s3:GetObject",
+        "s3:ListBucket",
+        "s3:PutObject",
+        "s3:PutObjectTagging",
+      ]
+      Resource = [
+        aws_s3_bucket.test.arn,
+        "${aws_s3_bucket.test.arn}/*",
+      ]
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccS3EndpointConfig(rName string) string {
+	return acctest.ConfigCompose(testAccS3EndpointConfigBase(rName), fmt.Sprintf(`
+resource "aws_dms_s3_endpoint" "test" {
+  endpoint_id             = %[1]q
+  endpoint_type           = "target"
+  bucket_name             = aws_s3_bucket.test.id
+  service_access_role_arn = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccS3EndpointConfigUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccS3EndpointConfigBase(rName), fmt.Sprintf(`
+resource "aws_dms_s3_endpoint" "test" {
+  endpoint_id             = %[1]q
+  endpoint_type           = "target"
+  bucket_name             = aws_s3_bucket.test.id
+  bucket_folder           = "folder"
+  service_access_role_arn = aws_iam_role.test.arn
+
+  add_column_name          = true
+  cdc_inserts_and_updates  = true
+  cdc_path                 = "cdc/path"
+  compression_type         = "GZIP"
+  csv_no_sup_value         = "x"
+  date_partition_enabled   = true
+  date_partition_sequence  = "YYYYMMDDHH"
+  include_op_for_full_load = true
+  max_file_size            = 120
+  preserve_transactions    = true
+  timestamp_column_name    = "tx_commit_time"
+  use_csv_no_sup_value     = true
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccS3EndpointConfigSource(rName string) string {
+	return acctest.ConfigCompose(testAccS3EndpointConfigBase(rName), fmt.Sprintf(`
+resource "aws_dms_s3_endpoint" "test" {
+  endpoint_id               = %[1]q
+  endpoint_type             = "source"
+  bucket_name               = aws_s3_bucket.test.id
+  service_access_role_arn   = aws_iam_role.test.arn
+  external_table_definition = jsonencode({
+    TableCount = 1
+    Tables = [{
+      TableName    = "test"
+      TablePath    = "dbo/test/"
+      TableOwner   = "dbo"
+      TableColumns = [{
+        ColumnName     = "id"
+        ColumnType     = "INT8"
+        ColumnNullable = false
+        ColumnIsPk     = true
+      }]
+      TableColumnsTotal = 1
+    }]
+  })
+  ignore_header_rows = 1
+  rfc_4180           = false
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccS3EndpointConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccS3EndpointConfigBase(rName), fmt.Sprintf(`
+resource "aws_dms_s3_endpoint" "test" {
+  endpoint_id             = %[1]q
+  endpoint_type           = "target"
+  bucket_name             = aws_s3_bucket.test.id
+  service_access_role_arn = aws_iam_role.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccS3EndpointConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccS3EndpointConfigBase(rName), fmt.Sprintf(`
+resource "aws_dms_s3_endpoint" "test" {
+  endpoint_id             = %[1]q
+  endpoint_type           = "target"
+  bucket_name             = aws_s3_bucket.test.id
+  service_access_role_arn = aws_iam_role.test.arn
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
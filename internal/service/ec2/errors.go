@@ -10,50 +10,52 @@ import (
 )
 
 const (
-	ErrCodeClientInvalidHostIDNotFound            = "Client.InvalidHostID.NotFound"
-	ErrCodeClientVpnAssociationIdNotFound         = "InvalidClientVpnAssociationId.NotFound"
-	ErrCodeClientVpnAuthorizationRuleNotFound     = "InvalidClientVpnEndpointAuthorizationRuleNotFound"
-	ErrCodeClientVpnEndpointIdNotFound            = "InvalidClientVpnEndpointId.NotFound"
-	ErrCodeClientVpnRouteNotFound                 = "InvalidClientVpnRouteNotFound"
-	ErrCodeDependencyViolation                    = "DependencyViolation"
-	ErrCodeGatewayNotAttached                     = "Gateway.NotAttached"
-	ErrCodeIncorrectState                         = "IncorrectState"
-	ErrCodeInvalidAssociationIDNotFound           = "InvalidAssociationID.NotFound"
-	ErrCodeInvalidAttachmentIDNotFound            = "InvalidAttachmentID.NotFound"
-	ErrCodeInvalidCarrierGatewayIDNotFound        = "InvalidCarrierGatewayID.NotFound"
-	ErrCodeInvalidCustomerGatewayIDNotFound       = "InvalidCustomerGatewayID.NotFound"
-	ErrCodeInvalidFlowLogIdNotFound               = "InvalidFlowLogId.NotFound"
-	ErrCodeInvalidGroupNotFound                   = "InvalidGroup.NotFound"
-	ErrCodeInvalidHostIDNotFound                  = "InvalidHostID.NotFound"
-	ErrCodeInvalidInstanceIDNotFound              = "InvalidInstanceID.NotFound"
-	ErrCodeInvalidInternetGatewayIDNotFound       = "InvalidInternetGatewayID.NotFound"
-	ErrCodeInvalidKeyPairNotFound                 = "InvalidKeyPair.NotFound"
-	ErrCodeInvalidNetworkInterfaceIDNotFound      = "InvalidNetworkInterfaceID.NotFound"
-	ErrCodeInvalidParameter                       = "InvalidParameter"
-	ErrCodeInvalidParameterException              = "InvalidParameterException"
-	ErrCodeInvalidParameterValue                  = "InvalidParameterValue"
-	ErrCodeInvalidPermissionDuplicate             = "InvalidPermission.Duplicate"
-	ErrCodeInvalidPermissionMalformed             = "InvalidPermission.Malformed"
-	ErrCodeInvalidPermissionNotFound              = "InvalidPermission.NotFound"
-	ErrCodeInvalidPlacementGroupUnknown           = "InvalidPlacementGroup.Unknown"
-	ErrCodeInvalidPrefixListIDNotFound            = "InvalidPrefixListID.NotFound"
-	ErrCodeInvalidRouteNotFound                   = "InvalidRoute.NotFound"
-	ErrCodeInvalidRouteTableIDNotFound            = "InvalidRouteTableID.NotFound"
-	ErrCodeInvalidRouteTableIdNotFound            = "InvalidRouteTableId.NotFound"
-	ErrCodeInvalidSecurityGroupIDNotFound         = "InvalidSecurityGroupID.NotFound"
-	ErrCodeInvalidSpotInstanceRequestIDNotFound   = "InvalidSpotInstanceRequestID.NotFound"
-	ErrCodeInvalidSubnetCidrReservationIDNotFound = "InvalidSubnetCidrReservationID.NotFound"
-	ErrCodeInvalidSubnetIDNotFound                = "InvalidSubnetID.NotFound"
-	ErrCodeInvalidSubnetIdNotFound                = "InvalidSubnetId.NotFound"
-	ErrCodeInvalidTransitGatewayIDNotFound        = "InvalidTransitGatewayID.NotFound"
-	ErrCodeInvalidVpcEndpointIdNotFound           = "InvalidVpcEndpointId.NotFound"
-	ErrCodeInvalidVpcEndpointNotFound             = "InvalidVpcEndpoint.NotFound"
-	ErrCodeInvalidVpcEndpointServiceIdNotFound    = "InvalidVpcEndpointServiceId.NotFound"
-	ErrCodeInvalidVpcIDNotFound                   = "InvalidVpcID.NotFound"
-	ErrCodeInvalidVpcPeeringConnectionIDNotFound  = "InvalidVpcPeeringConnectionID.NotFound"
-	ErrCodeInvalidVpnGatewayAttachmentNotFound    = "InvalidVpnGatewayAttachment.NotFound"
-	ErrCodeInvalidVpnGatewayIDNotFound            = "InvalidVpnGatewayID.NotFound"
-	ErrCodeInvalidSnapshotNotFound                = "InvalidSnapshot.NotFound"
+	ErrCodeClientInvalidHostIDNotFound                    = "Client.InvalidHostID.NotFound"
+	ErrCodeClientVpnAssociationIdNotFound                 = "InvalidClientVpnAssociationId.NotFound"
+	ErrCodeClientVpnAuthorizationRuleNotFound             = "InvalidClientVpnEndpointAuthorizationRuleNotFound"
+	ErrCodeClientVpnEndpointIdNotFound                    = "InvalidClientVpnEndpointId.NotFound"
+	ErrCodeClientVpnRouteNotFound                         = "InvalidClientVpnRouteNotFound"
+	ErrCodeDependencyViolation                            = "DependencyViolation"
+	ErrCodeGatewayNotAttached                             = "Gateway.NotAttached"
+	ErrCodeIncorrectState                                 = "IncorrectState"
+	ErrCodeInvalidAssociationIDNotFound                   = "InvalidAssociationID.NotFound"
+	ErrCodeInvalidAttachmentIDNotFound                    = "InvalidAttachmentID.NotFound"
+	ErrCodeInvalidCapacityReservationFleetIDNotFound      = "InvalidCapacityReservationFleetId.NotFound"
+	ErrCodeInvalidCarrierGatewayIDNotFound                = "InvalidCarrierGatewayID.NotFound"
+	ErrCodeInvalidCustomerGatewayIDNotFound               = "InvalidCustomerGatewayID.NotFound"
+	ErrCodeInvalidFlowLogIdNotFound                       = "InvalidFlowLogId.NotFound"
+	ErrCodeInvalidGroupNotFound                           = "InvalidGroup.NotFound"
+	ErrCodeInvalidHostIDNotFound                          = "InvalidHostID.NotFound"
+	ErrCodeInvalidInstanceIDNotFound                      = "InvalidInstanceID.NotFound"
+	ErrCodeInvalidInternetGatewayIDNotFound               = "InvalidInternetGatewayID.NotFound"
+	ErrCodeInvalidKeyPairNotFound                         = "InvalidKeyPair.NotFound"
+	ErrCodeInvalidNetworkInterfaceIDNotFound              = "InvalidNetworkInterfaceID.NotFound"
+	ErrCodeInvalidParameter                               = "InvalidParameter"
+	ErrCodeInvalidParameterException                      = "InvalidParameterException"
+	ErrCodeInvalidParameterValue                          = "InvalidParameterValue"
+	ErrCodeInvalidPermissionDuplicate                     = "InvalidPermission.Duplicate"
+	ErrCodeInvalidPermissionMalformed                     = "InvalidPermission.Malformed"
+	ErrCodeInvalidPermissionNotFound                      = "InvalidPermission.NotFound"
+	ErrCodeInvalidPlacementGroupUnknown                   = "InvalidPlacementGroup.Unknown"
+	ErrCodeInvalidPrefixListIDNotFound                    = "InvalidPrefixListID.NotFound"
+	ErrCodeInvalidRouteNotFound                           = "InvalidRoute.NotFound"
+	ErrCodeInvalidRouteTableIDNotFound                    = "InvalidRouteTableID.NotFound"
+	ErrCodeInvalidRouteTableIdNotFound                    = "InvalidRouteTableId.NotFound"
+	ErrCodeInvalidSecurityGroupIDNotFound                 = "InvalidSecurityGroupID.NotFound"
+	ErrCodeInvalidSpotInstanceRequestIDNotFound           = "InvalidSpotInstanceRequestID.NotFound"
+	ErrCodeInvalidSubnetCidrReservationIDNotFound         = "InvalidSubnetCidrReservationID.NotFound"
+	ErrCodeInvalidSubnetIDNotFound                        = "InvalidSubnetID.NotFound"
+	ErrCodeInvalidSubnetIdNotFound                        = "InvalidSubnetId.NotFound"
+	ErrCodeInvalidTransitGatewayIDNotFound                = "InvalidTransitGatewayID.NotFound"
+	ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound = "InvalidTransitGatewayMulticastDomainId.NotFound"
+	ErrCodeInvalidVpcEndpointIdNotFound                   = "InvalidVpcEndpointId.NotFound"
+	ErrCodeInvalidVpcEndpointNotFound                     = "InvalidVpcEndpoint.NotFound"
+	ErrCodeInvalidVpcEndpointServiceIdNotFound            = "InvalidVpcEndpointServiceId.NotFound"
+	ErrCodeInvalidVpcIDNotFound                           = "InvalidVpcID.NotFound"
+	ErrCodeInvalidVpcPeeringConnectionIDNotFound          = "InvalidVpcPeeringConnectionID.NotFound"
+	ErrCodeInvalidVpnGatewayAttachmentNotFound            = "InvalidVpnGatewayAttachment.NotFound"
+	ErrCodeInvalidVpnGatewayIDNotFound                    = "InvalidVpnGatewayID.NotFound"
+	ErrCodeInvalidSnapshotNotFound                        = "InvalidSnapshot.NotFound"
 )
 
 func UnsuccessfulItemError(apiObject *ec2.UnsuccessfulItemError) error {
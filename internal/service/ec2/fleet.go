@@ -44,6 +44,31 @@ func ResourceFleet() *schema.Resource {
 					ec2.FleetExcessCapacityTerminationPolicyTermination,
 				}, false),
 			},
+			"fleet_instance_set": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"instance_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lifecycle": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"platform": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"launch_template_config": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -321,6 +346,7 @@ func ResourceFleet() *schema.Resource {
 				ForceNew: true,
 				Default:  ec2.FleetTypeMaintain,
 				ValidateFunc: validation.StringInSlice([]string{
+					ec2.FleetTypeInstant,
 					ec2.FleetTypeMaintain,
 					ec2.FleetTypeRequest,
 				}, false),
@@ -454,6 +480,10 @@ func resourceFleetRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("excess_capacity_termination_policy", fleet.ExcessCapacityTerminationPolicy)
 
+	if err := d.Set("fleet_instance_set", flattenEc2FleetInstances(fleet.Instances)); err != nil {
+		return fmt.Errorf("error setting fleet_instance_set: %s", err)
+	}
+
 	if err := d.Set("launch_template_config", flattenEc2FleetLaunchTemplateConfigs(fleet.LaunchTemplateConfigs)); err != nil {
 		return fmt.Errorf("error setting launch_template_config: %s", err)
 	}
@@ -806,6 +836,26 @@ func expandEc2TargetCapacitySpecificationRequest(l []interface{}) *ec2.TargetCap
 	return targetCapacitySpecificationrequest
 }
 
+func flattenEc2FleetInstances(fleetInstances []*ec2.DescribeFleetsInstances) []interface{} {
+	l := make([]interface{}, len(fleetInstances))
+
+	for i, fleetInstance := range fleetInstances {
+		if fleetInstance == nil {
+			l[i] = map[string]interface{}{}
+			continue
+		}
+		m := map[string]interface{}{
+			"instance_ids":  aws.StringValueSlice(fleetInstance.InstanceIds),
+			"instance_type": aws.StringValue(fleetInstance.InstanceType),
+			"lifecycle":     aws.StringValue(fleetInstance.Lifecycle),
+			"platform":      aws.StringValue(fleetInstance.Platform),
+		}
+		l[i] = m
+	}
+
+	return l
+}
+
 func flattenEc2FleetLaunchTemplateConfigs(fleetLaunchTemplateConfigs []*ec2.FleetLaunchTemplateConfig) []interface{} {
 	l := make([]interface{}, len(fleetLaunchTemplateConfigs))
 
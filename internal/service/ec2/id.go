@@ -113,6 +113,44 @@ func TransitGatewayPrefixListReferenceParseID(id string) (string, string, error)
 	return "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected transit-gateway-route-table-id%[2]sprefix-list-id", id, transitGatewayPrefixListReferenceSeparator)
 }
 
+const transitGatewayMulticastDomainAssociationIDSeparator = "/"
+
+func TransitGatewayMulticastDomainAssociationCreateID(multicastDomainID, attachmentID, subnetID string) string {
+	parts := []string{multicastDomainID, attachmentID, subnetID}
+	id := strings.Join(parts, transitGatewayMulticastDomainAssociationIDSeparator)
+
+	return id
+}
+
+func TransitGatewayMulticastDomainAssociationParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, transitGatewayMulticastDomainAssociationIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected multicast-domain-id%[2]sattachment-id%[2]ssubnet-id", id, transitGatewayMulticastDomainAssociationIDSeparator)
+}
+
+const transitGatewayMulticastGroupMemberIDSeparator = "/"
+
+func TransitGatewayMulticastGroupMemberCreateID(multicastDomainID, groupIPAddress, networkInterfaceID string) string {
+	parts := []string{multicastDomainID, groupIPAddress, networkInterfaceID}
+	id := strings.Join(parts, transitGatewayMulticastGroupMemberIDSeparator)
+
+	return id
+}
+
+func TransitGatewayMulticastGroupMemberParseID(id string) (string, string, string, error) {
+	parts := strings.Split(id, transitGatewayMulticastGroupMemberIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" && parts[1] != "" && parts[2] != "" {
+		return parts[0], parts[1], parts[2], nil
+	}
+
+	return "", "", "", fmt.Errorf("unexpected format for ID (%[1]s), expected multicast-domain-id%[2]sgroup-ip-address%[2]snetwork-interface-id", id, transitGatewayMulticastGroupMemberIDSeparator)
+}
+
 func VPCEndpointRouteTableAssociationCreateID(vpcEndpointID, routeTableID string) string {
 	return fmt.Sprintf("a-%s%d", vpcEndpointID, create.StringHashcode(routeTableID))
 }
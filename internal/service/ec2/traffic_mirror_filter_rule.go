@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 )
 
@@ -23,6 +24,9 @@ func ResourceTrafficMirrorFilterRule() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceTrafficMirrorFilterRuleImport,
 		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
 		Schema: map[string]*schema.Schema{
 			"arn": {
 				Type:     schema.TypeString,
@@ -101,6 +105,8 @@ func ResourceTrafficMirrorFilterRule() *schema.Resource {
 					},
 				},
 			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
 			"traffic_direction": {
 				Type:     schema.TypeString,
 				Required: true,
@@ -115,6 +121,8 @@ func ResourceTrafficMirrorFilterRule() *schema.Resource {
 
 func resourceTrafficMirrorFilterRuleCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
 
 	filterId := d.Get("traffic_mirror_filter_id")
 
@@ -149,11 +157,21 @@ func resourceTrafficMirrorFilterRuleCreate(d *schema.ResourceData, meta interfac
 	}
 
 	d.SetId(aws.StringValue(out.TrafficMirrorFilterRule.TrafficMirrorFilterRuleId))
+
+	// CreateTrafficMirrorFilterRule does not accept tag specifications.
+	if len(tags) > 0 {
+		if err := CreateTags(conn, d.Id(), tags); err != nil {
+			return fmt.Errorf("error adding EC2 Traffic Mirror Filter Rule (%s) tags: %w", d.Id(), err)
+		}
+	}
+
 	return resourceTrafficMirrorFilterRuleRead(d, meta)
 }
 
 func resourceTrafficMirrorFilterRuleRead(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).EC2Conn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
 	ruleId := d.Id()
 
 	var rule *ec2.TrafficMirrorFilterRule
@@ -206,6 +224,23 @@ func resourceTrafficMirrorFilterRuleRead(d *schema.ResourceData, meta interface{
 
 	d.Set("arn", arn)
 
+	tags, err := ListTags(conn, d.Id())
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for EC2 Traffic Mirror Filter Rule (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
 	return nil
 }
 
@@ -239,6 +274,18 @@ func resourceTrafficMirrorFilterRuleUpdate(d *schema.ResourceData, meta interfac
 	conn := meta.(*conns.AWSClient).EC2Conn
 	ruleId := d.Id()
 
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, ruleId, o, n); err != nil {
+			return fmt.Errorf("error updating EC2 Traffic Mirror Filter Rule (%s) tags: %w", ruleId, err)
+		}
+	}
+
+	if !d.HasChangesExcept("tags", "tags_all") {
+		return resourceTrafficMirrorFilterRuleRead(d, meta)
+	}
+
 	input := &ec2.ModifyTrafficMirrorFilterRuleInput{
 		TrafficMirrorFilterRuleId: &ruleId,
 	}
@@ -134,6 +134,58 @@ func TestAccEC2TrafficMirrorFilterRule_disappears(t *testing.T) {
 	})
 }
 
+func TestAccEC2TrafficMirrorFilterRule_tags(t *testing.T) {
+	resourceName := "aws_ec2_traffic_mirror_filter_rule.test"
+	dstCidr := "10.0.0.0/8"
+	srcCidr := "0.0.0.0/0"
+	ruleNum := 1
+	action := "accept"
+	direction := "ingress"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			testAccPreCheckTrafficMirrorFilterRule(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTrafficMirrorFilterRuleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEc2TrafficMirrorFilterRuleConfigTags1(dstCidr, srcCidr, action, direction, ruleNum, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTrafficMirrorFilterRuleImportStateIdFunc(resourceName),
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccEc2TrafficMirrorFilterRuleConfigTags2(dstCidr, srcCidr, action, direction, ruleNum, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccEc2TrafficMirrorFilterRuleConfigTags1(dstCidr, srcCidr, action, direction, ruleNum, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTrafficMirrorFilterRuleExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckTrafficMirrorFilterRuleExists(name string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -224,6 +276,47 @@ resource "aws_ec2_traffic_mirror_filter_rule" "test" {
 `, dstCidr, action, ruleNum, srcCidr, dir, description, protocol, srcPortFrom, srcPortTo, dstPortFrom, dstPortTo)
 }
 
+func testAccEc2TrafficMirrorFilterRuleConfigTags1(dstCidr, srcCidr, action, dir string, num int, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "test" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = %[1]q
+  rule_action              = %[2]q
+  rule_number              = %[3]d
+  source_cidr_block        = %[4]q
+  traffic_direction        = %[5]q
+
+  tags = {
+    %[6]q = %[7]q
+  }
+}
+`, dstCidr, action, num, srcCidr, dir, tagKey1, tagValue1)
+}
+
+func testAccEc2TrafficMirrorFilterRuleConfigTags2(dstCidr, srcCidr, action, dir string, num int, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_traffic_mirror_filter" "test" {
+}
+
+resource "aws_ec2_traffic_mirror_filter_rule" "test" {
+  traffic_mirror_filter_id = aws_ec2_traffic_mirror_filter.test.id
+  destination_cidr_block   = %[1]q
+  rule_action              = %[2]q
+  rule_number              = %[3]d
+  source_cidr_block        = %[4]q
+  traffic_direction        = %[5]q
+
+  tags = {
+    %[6]q = %[7]q
+    %[8]q = %[9]q
+  }
+}
+`, dstCidr, action, num, srcCidr, dir, tagKey1, tagValue1, tagKey2, tagValue2)
+}
+
 func testAccPreCheckTrafficMirrorFilterRule(t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn
 
@@ -0,0 +1,131 @@
+package ec2
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceTransitGatewayMulticastGroupMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTransitGatewayMulticastGroupMemberCreate,
+		Read:   resourceTransitGatewayMulticastGroupMemberRead,
+		Delete: resourceTransitGatewayMulticastGroupMemberDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_ip_address": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"network_interface_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_gateway_multicast_domain_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func resourceTransitGatewayMulticastGroupMemberCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	multicastDomainID := d.Get("transit_gateway_multicast_domain_id").(string)
+	groupIPAddress := d.Get("group_ip_address").(string)
+	networkInterfaceID := d.Get("network_interface_id").(string)
+	id := TransitGatewayMulticastGroupMemberCreateID(multicastDomainID, groupIPAddress, networkInterfaceID)
+	input := &ec2.RegisterTransitGatewayMulticastGroupMembersInput{
+		GroupIpAddress:                  aws.String(groupIPAddress),
+		NetworkInterfaceIds:             aws.StringSlice([]string{networkInterfaceID}),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	}
+
+	log.Printf("[DEBUG] Creating EC2 Transit Gateway Multicast Group Member: %s", input)
+	_, err := conn.RegisterTransitGatewayMulticastGroupMembers(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating EC2 Transit Gateway Multicast Group Member (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	return resourceTransitGatewayMulticastGroupMemberRead(d, meta)
+}
+
+func resourceTransitGatewayMulticastGroupMemberRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	multicastDomainID, groupIPAddress, networkInterfaceID, err := TransitGatewayMulticastGroupMemberParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	// Group membership is not immediately visible after registration.
+	outputRaw, err := tfresource.RetryWhenNewResourceNotFound(PropagationTimeout, func() (interface{}, error) {
+		return FindTransitGatewayMulticastGroupMember(conn, multicastDomainID, groupIPAddress, networkInterfaceID)
+	}, d.IsNewResource())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Transit Gateway Multicast Group Member (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading EC2 Transit Gateway Multicast Group Member (%s): %w", d.Id(), err)
+	}
+
+	groupMember := outputRaw.(*ec2.TransitGatewayMulticastGroup)
+
+	d.Set("group_ip_address", groupMember.GroupIpAddress)
+	d.Set("network_interface_id", groupMember.NetworkInterfaceId)
+	d.Set("transit_gateway_multicast_domain_id", multicastDomainID)
+
+	return nil
+}
+
+func resourceTransitGatewayMulticastGroupMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).EC2Conn
+
+	multicastDomainID, groupIPAddress, networkInterfaceID, err := TransitGatewayMulticastGroupMemberParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Deleting EC2 Transit Gateway Multicast Group Member: %s", d.Id())
+	_, err = conn.DeregisterTransitGatewayMulticastGroupMembers(&ec2.DeregisterTransitGatewayMulticastGroupMembersInput{
+		GroupIpAddress:                  aws.String(groupIPAddress),
+		NetworkInterfaceIds:             aws.StringSlice([]string{networkInterfaceID}),
+		TransitGatewayMulticastDomainId: aws.String(multicastDomainID),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ErrCodeInvalidTransitGatewayMulticastDomainIdNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting EC2 Transit Gateway Multicast Group Member (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
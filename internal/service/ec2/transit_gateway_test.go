@@ -28,9 +28,24 @@ func TestAccEC2TransitGateway_serial(t *testing.T) {
 			"DefaultRouteTablePropagation":                       testAccTransitGateway_DefaultRouteTablePropagation,
 			"Description":                                        testAccTransitGateway_Description,
 			"DnsSupport":                                         testAccTransitGateway_DNSSupport,
+			"MulticastSupport":                                   testAccTransitGateway_MulticastSupport,
 			"Tags":                                               testAccTransitGateway_Tags,
 			"VpnEcmpSupport":                                     testAccTransitGateway_VPNECMPSupport,
 		},
+		"MulticastDomain": {
+			"basic":      testAccTransitGatewayMulticastDomain_basic,
+			"disappears": testAccTransitGatewayMulticastDomain_disappears,
+			"Options":    testAccTransitGatewayMulticastDomain_Options,
+			"Tags":       testAccTransitGatewayMulticastDomain_Tags,
+		},
+		"MulticastDomainAssociation": {
+			"basic":      testAccTransitGatewayMulticastDomainAssociation_basic,
+			"disappears": testAccTransitGatewayMulticastDomainAssociation_disappears,
+		},
+		"MulticastGroupMember": {
+			"basic":      testAccTransitGatewayMulticastGroupMember_basic,
+			"disappears": testAccTransitGatewayMulticastGroupMember_disappears,
+		},
 		"PeeringAttachment": {
 			"basic":            testAccTransitGatewayPeeringAttachment_basic,
 			"disappears":       testAccTransitGatewayPeeringAttachment_disappears,
@@ -372,6 +387,32 @@ func testAccTransitGateway_DNSSupport(t *testing.T) {
 	})
 }
 
+func testAccTransitGateway_MulticastSupport(t *testing.T) {
+	var v ec2.TransitGateway
+	resourceName := "aws_ec2_transit_gateway.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckTransitGateway(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ec2.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckTransitGatewayDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayMulticastSupportConfig(ec2.MulticastSupportValueEnable),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "multicast_support", ec2.MulticastSupportValueEnable),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func testAccTransitGateway_VPNECMPSupport(t *testing.T) {
 	var transitGateway1, transitGateway2 ec2.TransitGateway
 	resourceName := "aws_ec2_transit_gateway.test"
@@ -722,6 +763,14 @@ resource "aws_ec2_transit_gateway" "test" {
 `, dnsSupport)
 }
 
+func testAccTransitGatewayMulticastSupportConfig(multicastSupport string) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  multicast_support = %q
+}
+`, multicastSupport)
+}
+
 func testAccTransitGatewayVPNECMPSupportConfig(vpnEcmpSupport string) string {
 	return fmt.Sprintf(`
 resource "aws_ec2_transit_gateway" "test" {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -11,6 +12,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -97,6 +99,12 @@ func ResourceGlobalReplicationGroup() *schema.Resource {
 				DiffSuppressFunc: elasticacheDescriptionDiffSuppress,
 				StateFunc:        elasticacheDescriptionStateFunc,
 			},
+			"num_node_groups": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			// global_replication_group_members cannot be correctly implemented because any secondary
 			// replication groups will be added after this resource completes.
 			// "global_replication_group_members": {
@@ -167,10 +175,20 @@ func resourceGlobalReplicationGroupCreate(d *schema.ResourceData, meta interface
 
 	d.SetId(aws.StringValue(output.GlobalReplicationGroup.GlobalReplicationGroupId))
 
-	if _, err := WaitGlobalReplicationGroupAvailable(conn, d.Id(), GlobalReplicationGroupDefaultCreatedTimeout); err != nil {
+	globalReplicationGroup, err := WaitGlobalReplicationGroupAvailable(conn, d.Id(), GlobalReplicationGroupDefaultCreatedTimeout)
+
+	if err != nil {
 		return fmt.Errorf("error waiting for ElastiCache Global Replication Group (%s) availability: %w", d.Id(), err)
 	}
 
+	if v, ok := d.GetOk("num_node_groups"); ok {
+		if oldNodeGroupCount, newNodeGroupCount := len(globalReplicationGroup.GlobalNodeGroups), v.(int); newNodeGroupCount != oldNodeGroupCount {
+			if err := updateElasticacheGlobalReplicationGroupNodeGroupCount(conn, d.Id(), oldNodeGroupCount, newNodeGroupCount); err != nil {
+				return fmt.Errorf("error updating ElastiCache Global Replication Group (%s) node group count: %w", d.Id(), err)
+			}
+		}
+	}
+
 	return resourceGlobalReplicationGroupRead(d, meta)
 }
 
@@ -203,6 +221,7 @@ func resourceGlobalReplicationGroupRead(d *schema.ResourceData, meta interface{}
 	d.Set("actual_engine_version", globalReplicationGroup.EngineVersion)
 	d.Set("global_replication_group_description", globalReplicationGroup.GlobalReplicationGroupDescription)
 	d.Set("global_replication_group_id", globalReplicationGroup.GlobalReplicationGroupId)
+	d.Set("num_node_groups", len(globalReplicationGroup.GlobalNodeGroups))
 	d.Set("transit_encryption_enabled", globalReplicationGroup.TransitEncryptionEnabled)
 
 	d.Set("primary_replication_group_id", flattenElasticacheGlobalReplicationGroupPrimaryGroupID(globalReplicationGroup.Members))
@@ -229,9 +248,96 @@ func resourceGlobalReplicationGroupUpdate(d *schema.ResourceData, meta interface
 		}
 	}
 
+	if d.HasChange("num_node_groups") {
+		o, n := d.GetChange("num_node_groups")
+
+		if err := updateElasticacheGlobalReplicationGroupNodeGroupCount(conn, d.Id(), o.(int), n.(int)); err != nil {
+			return fmt.Errorf("error updating ElastiCache Global Replication Group (%s) node group count: %w", d.Id(), err)
+		}
+	}
+
 	return resourceGlobalReplicationGroupRead(d, meta)
 }
 
+func updateElasticacheGlobalReplicationGroupNodeGroupCount(conn *elasticache.ElastiCache, id string, oldNodeGroupCount, newNodeGroupCount int) error {
+	var f func() error
+
+	if newNodeGroupCount > oldNodeGroupCount {
+		input := &elasticache.IncreaseNodeGroupsInGlobalReplicationGroupInput{
+			ApplyImmediately:         aws.Bool(true),
+			GlobalReplicationGroupId: aws.String(id),
+			NodeGroupCount:           aws.Int64(int64(newNodeGroupCount)),
+		}
+
+		f = func() error {
+			_, err := conn.IncreaseNodeGroupsInGlobalReplicationGroup(input)
+			return err
+		}
+	} else if newNodeGroupCount < oldNodeGroupCount {
+		globalReplicationGroup, err := FindGlobalReplicationGroupByID(conn, id)
+
+		if err != nil {
+			return fmt.Errorf("reading: %w", err)
+		}
+
+		// Remove the highest numbered node groups, mirroring how shards are removed from a replication group.
+		var nodeGroupIDs []string
+		for _, v := range globalReplicationGroup.GlobalNodeGroups {
+			nodeGroupIDs = append(nodeGroupIDs, aws.StringValue(v.GlobalNodeGroupId))
+		}
+		sort.Strings(nodeGroupIDs)
+
+		if len(nodeGroupIDs) <= newNodeGroupCount {
+			return nil
+		}
+
+		input := &elasticache.DecreaseNodeGroupsInGlobalReplicationGroupInput{
+			ApplyImmediately:         aws.Bool(true),
+			GlobalNodeGroupsToRemove: aws.StringSlice(nodeGroupIDs[newNodeGroupCount:]),
+			GlobalReplicationGroupId: aws.String(id),
+			NodeGroupCount:           aws.Int64(int64(newNodeGroupCount)),
+		}
+
+		f = func() error {
+			_, err := conn.DecreaseNodeGroupsInGlobalReplicationGroup(input)
+			return err
+		}
+	} else {
+		return nil
+	}
+
+	// The request is rejected while the global datastore or one of its members is still
+	// moving slots or replicas from an earlier change, so retry until that has drained.
+	err := resource.Retry(GlobalReplicationGroupDefaultUpdatedTimeout, func() *resource.RetryError {
+		err := f()
+
+		if tfawserr.ErrCodeEquals(err, elasticache.ErrCodeInvalidGlobalReplicationGroupStateFault) ||
+			tfawserr.ErrCodeEquals(err, elasticache.ErrCodeInvalidReplicationGroupStateFault) {
+			return resource.RetryableError(err)
+		}
+
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		return nil
+	})
+
+	if tfresource.TimedOut(err) {
+		err = f()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := WaitGlobalReplicationGroupAvailable(conn, id, GlobalReplicationGroupDefaultUpdatedTimeout); err != nil {
+		return fmt.Errorf("waiting for completion: %w", err)
+	}
+
+	return nil
+}
+
 type elasticacheGlobalReplicationGroupUpdater func(input *elasticache.ModifyGlobalReplicationGroupInput)
 
 func updateElasticacheGlobalReplicationGroup(conn *elasticache.ElastiCache, id string, f elasticacheGlobalReplicationGroupUpdater) error {
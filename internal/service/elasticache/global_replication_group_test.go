@@ -212,6 +212,49 @@ func TestAccElastiCacheGlobalReplicationGroup_clusterMode(t *testing.T) {
 	})
 }
 
+func TestAccElastiCacheGlobalReplicationGroup_SetNumNodeGroups(t *testing.T) {
+	var globalReplicationGroup elasticache.GlobalReplicationGroup
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resourceName := "aws_elasticache_global_replication_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheckGlobalReplicationGroup(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, elasticache.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGlobalReplicationGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGlobalReplicationGroupConfig_NumNodeGroups(rName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(resourceName, &globalReplicationGroup),
+					resource.TestCheckResourceAttr(resourceName, "num_node_groups", "2"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccGlobalReplicationGroupConfig_NumNodeGroups(rName, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(resourceName, &globalReplicationGroup),
+					resource.TestCheckResourceAttr(resourceName, "num_node_groups", "3"),
+				),
+			},
+			{
+				Config: testAccGlobalReplicationGroupConfig_NumNodeGroups(rName, 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGlobalReplicationGroupExists(resourceName, &globalReplicationGroup),
+					resource.TestCheckResourceAttr(resourceName, "num_node_groups", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckGlobalReplicationGroupExists(resourceName string, v *elasticache.GlobalReplicationGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -482,6 +525,37 @@ resource "aws_elasticache_replication_group" "test" {
 `, rName)
 }
 
+func testAccGlobalReplicationGroupConfig_NumNodeGroups(rName string, numNodeGroups int) string {
+	return fmt.Sprintf(`
+resource "aws_elasticache_global_replication_group" "test" {
+  global_replication_group_id_suffix = %[1]q
+  primary_replication_group_id       = aws_elasticache_replication_group.test.id
+
+  num_node_groups = %[2]d
+}
+
+resource "aws_elasticache_replication_group" "test" {
+  replication_group_id          = %[1]q
+  replication_group_description = "test"
+
+  engine         = "redis"
+  engine_version = "6.x"
+  node_type      = "cache.m5.large"
+
+  parameter_group_name       = "default.redis6.x.cluster.on"
+  automatic_failover_enabled = true
+  cluster_mode {
+    num_node_groups         = 2
+    replicas_per_node_group = 1
+  }
+
+  lifecycle {
+    ignore_changes = [cluster_mode, number_cache_clusters]
+  }
+}
+`, rName, numNodeGroups)
+}
+
 func testAccElasticacheVpcBaseWithProvider(rName, name, provider string, subnetCount int) string {
 	return acctest.ConfigCompose(
 		testAccAvailableAZsNoOptInConfigWithProvider(name, provider),
@@ -76,6 +76,10 @@ func ResourceStudio() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: verify.ValidARN,
 			},
+			"studio_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"subnet_ids": {
 				Type:     schema.TypeSet,
 				MaxItems: 5,
@@ -195,6 +199,13 @@ func resourceStudioUpdate(d *schema.ResourceData, meta interface{}) error {
 		if d.HasChange("subnet_ids") {
 			input.SubnetIds = flex.ExpandStringSet(d.Get("subnet_ids").(*schema.Set))
 		}
+
+		log.Printf("[DEBUG] Updating EMR Studio: %s", input)
+		_, err := conn.UpdateStudio(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating EMR Studio (%s): %w", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("tags_all") {
@@ -233,6 +244,7 @@ func resourceStudioRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("idp_relay_state_parameter_name", studio.IdpRelayStateParameterName)
 	d.Set("name", studio.Name)
 	d.Set("service_role", studio.ServiceRole)
+	d.Set("studio_id", studio.StudioId)
 	d.Set("url", studio.Url)
 	d.Set("user_role", studio.UserRole)
 	d.Set("vpc_id", studio.VpcId)
@@ -34,6 +34,7 @@ func TestAccEMRStudio_sso(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "auth_mode", "SSO"),
 					resource.TestCheckResourceAttrSet(resourceName, "url"),
+					resource.TestCheckResourceAttrPair(resourceName, "studio_id", resourceName, "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.test", "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "workspace_security_group_id", "aws_security_group.test", "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "engine_security_group_id", "aws_security_group.test", "id"),
@@ -70,6 +71,7 @@ func TestAccEMRStudio_iam(t *testing.T) {
 					resource.TestCheckResourceAttr(resourceName, "name", rName),
 					resource.TestCheckResourceAttr(resourceName, "auth_mode", "IAM"),
 					resource.TestCheckResourceAttrSet(resourceName, "url"),
+					resource.TestCheckResourceAttrPair(resourceName, "studio_id", resourceName, "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "vpc_id", "aws_vpc.test", "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "workspace_security_group_id", "aws_security_group.test", "id"),
 					resource.TestCheckResourceAttrPair(resourceName, "engine_security_group_id", "aws_security_group.test", "id"),
@@ -87,6 +89,44 @@ func TestAccEMRStudio_iam(t *testing.T) {
 	})
 }
 
+func TestAccEMRStudio_update(t *testing.T) {
+	var studio emr.Studio
+	resourceName := "aws_emr_studio.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	rNameUpdated := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, emr.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckEmrStudioDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEMRStudioConfigIAM(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmrStudioExists(resourceName, &studio),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "description", ""),
+				),
+			},
+			{
+				Config: testAccEMRStudioConfigUpdated(rName, rNameUpdated, "test description"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEmrStudioExists(resourceName, &studio),
+					resource.TestCheckResourceAttr(resourceName, "name", rNameUpdated),
+					resource.TestCheckResourceAttr(resourceName, "description", "test description"),
+					resource.TestCheckResourceAttr(resourceName, "default_s3_location", fmt.Sprintf("s3://%s/updated", rName)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
 func TestAccEMRStudio_disappears(t *testing.T) {
 	var studio emr.Studio
 	resourceName := "aws_emr_studio.test"
@@ -300,6 +340,22 @@ resource "aws_emr_studio" "test" {
 `, rName))
 }
 
+func testAccEMRStudioConfigUpdated(rName, name, description string) string {
+	return acctest.ConfigCompose(testAccEMRStudioConfigBase(rName), fmt.Sprintf(`
+resource "aws_emr_studio" "test" {
+  auth_mode                   = "IAM"
+  default_s3_location         = "s3://${aws_s3_bucket.test.bucket}/updated"
+  description                 = %[2]q
+  engine_security_group_id    = aws_security_group.test.id
+  name                        = %[1]q
+  service_role                = aws_iam_role.test.arn
+  subnet_ids                  = [aws_subnet.test.id]
+  vpc_id                      = aws_vpc.test.id
+  workspace_security_group_id = aws_security_group.test.id
+}
+`, name, description))
+}
+
 func testAccEMRStudioConfigTags1(rName, tagKey1, tagValue1 string) string {
 	return acctest.ConfigCompose(testAccEMRStudioConfigBase(rName), fmt.Sprintf(`
 resource "aws_emr_studio" "test" {
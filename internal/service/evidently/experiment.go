@@ -0,0 +1,526 @@
+package evidently
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceExperiment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceExperimentCreate,
+		ReadContext:   resourceExperimentRead,
+		UpdateContext: resourceExperimentUpdate,
+		DeleteContext: resourceExperimentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 160),
+			},
+			"execution": executionSchema(),
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metric_goals": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"desired_change": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      cloudwatchevidently.ChangeDirectionEnumIncrease,
+							ValidateFunc: validation.StringInSlice(cloudwatchevidently.ChangeDirectionEnum_Values(), false),
+						},
+						"metric_definition": metricDefinitionSchema(),
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"online_ab_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"control_treatment_name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validName,
+						},
+						"treatment_weights": {
+							Type:     schema.TypeMap,
+							Optional: true,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeInt,
+								ValidateFunc: validation.IntBetween(0, 100_000),
+							},
+						},
+					},
+				},
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"randomization_salt": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 127),
+			},
+			"sampling_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(0, 100_000),
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"treatments": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 160),
+						},
+						"feature": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validName,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validName,
+						},
+						"variation": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validName,
+						},
+					},
+				},
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceExperimentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	project := d.Get("project").(string)
+	metricGoals, err := expandMetricGoalConfigs(d.Get("metric_goals").([]interface{}))
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &cloudwatchevidently.CreateExperimentInput{
+		MetricGoals: metricGoals,
+		Name:        aws.String(name),
+		Project:     aws.String(project),
+		Treatments:  expandTreatmentConfigs(d.Get("treatments").([]interface{})),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("online_ab_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.OnlineAbConfig = expandOnlineAbConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("randomization_salt"); ok {
+		input.RandomizationSalt = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sampling_rate"); ok {
+		input.SamplingRate = aws.Int64(int64(v.(int)))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Evidently Experiment: %s", input)
+	_, err = conn.CreateExperimentWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating CloudWatch Evidently Experiment (%s): %s", name, err)
+	}
+
+	d.SetId(experimentCreateResourceID(name, project))
+
+	if _, err := waitExperimentUpdated(ctx, conn, name, project); err != nil {
+		return diag.Errorf("error waiting for CloudWatch Evidently Experiment (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceExperimentRead(ctx, d, meta)
+}
+
+func resourceExperimentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name, project, err := ExperimentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	experiment, err := FindExperimentByNameAndProject(ctx, conn, name, project)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Evidently Experiment (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading CloudWatch Evidently Experiment (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", experiment.Arn)
+	d.Set("created_time", aws.TimeValue(experiment.CreatedTime).Format(time.RFC3339))
+	d.Set("description", experiment.Description)
+
+	if v := experiment.Execution; v != nil {
+		if err := d.Set("execution", flattenExecution(v.StartedTime, v.EndedTime)); err != nil {
+			return diag.Errorf("error setting execution: %s", err)
+		}
+	} else {
+		d.Set("execution", nil)
+	}
+
+	d.Set("last_updated_time", aws.TimeValue(experiment.LastUpdatedTime).Format(time.RFC3339))
+
+	metricGoals, err := flattenMetricGoals(experiment.MetricGoals)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("metric_goals", metricGoals); err != nil {
+		return diag.Errorf("error setting metric_goals: %s", err)
+	}
+
+	d.Set("name", experiment.Name)
+
+	if err := d.Set("online_ab_config", flattenOnlineAbDefinition(experiment.OnlineAbDefinition)); err != nil {
+		return diag.Errorf("error setting online_ab_config: %s", err)
+	}
+
+	d.Set("project", project)
+	d.Set("randomization_salt", experiment.RandomizationSalt)
+	d.Set("sampling_rate", experiment.SamplingRate)
+	d.Set("status", experiment.Status)
+	d.Set("status_reason", experiment.StatusReason)
+
+	if err := d.Set("treatments", flattenTreatments(experiment.Treatments)); err != nil {
+		return diag.Errorf("error setting treatments: %s", err)
+	}
+
+	d.Set("type", experiment.Type)
+
+	tags := KeyValueTags(experiment.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceExperimentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		name, project, err := ExperimentParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		metricGoals, err := expandMetricGoalConfigs(d.Get("metric_goals").([]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &cloudwatchevidently.UpdateExperimentInput{
+			Description: aws.String(d.Get("description").(string)),
+			Experiment:  aws.String(name),
+			MetricGoals: metricGoals,
+			Project:     aws.String(project),
+			Treatments:  expandTreatmentConfigs(d.Get("treatments").([]interface{})),
+		}
+
+		if v, ok := d.GetOk("online_ab_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.OnlineAbConfig = expandOnlineAbConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if d.HasChange("randomization_salt") {
+			input.RandomizationSalt = aws.String(d.Get("randomization_salt").(string))
+		}
+
+		if d.HasChange("sampling_rate") {
+			input.SamplingRate = aws.Int64(int64(d.Get("sampling_rate").(int)))
+		}
+
+		log.Printf("[DEBUG] Updating CloudWatch Evidently Experiment: %s", input)
+		_, err = conn.UpdateExperimentWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating CloudWatch Evidently Experiment (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitExperimentUpdated(ctx, conn, name, project); err != nil {
+			return diag.Errorf("error waiting for CloudWatch Evidently Experiment (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating CloudWatch Evidently Experiment (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceExperimentRead(ctx, d, meta)
+}
+
+func resourceExperimentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+
+	name, project, err := ExperimentParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting CloudWatch Evidently Experiment: %s", d.Id())
+	_, err = conn.DeleteExperimentWithContext(ctx, &cloudwatchevidently.DeleteExperimentInput{
+		Experiment: aws.String(name),
+		Project:    aws.String(project),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchevidently.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting CloudWatch Evidently Experiment (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitExperimentDeleted(ctx, conn, name, project); err != nil {
+		return diag.Errorf("error waiting for CloudWatch Evidently Experiment (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandMetricGoalConfigs(tfList []interface{}) ([]*cloudwatchevidently.MetricGoalConfig, error) {
+	var apiObjects []*cloudwatchevidently.MetricGoalConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		metricDefinition, err := expandMetricDefinitionConfig(tfMap["metric_definition"].([]interface{}))
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject := &cloudwatchevidently.MetricGoalConfig{
+			MetricDefinition: metricDefinition,
+		}
+
+		if v, ok := tfMap["desired_change"].(string); ok && v != "" {
+			apiObject.DesiredChange = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+func expandOnlineAbConfig(tfMap map[string]interface{}) *cloudwatchevidently.OnlineAbConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudwatchevidently.OnlineAbConfig{}
+
+	if v, ok := tfMap["control_treatment_name"].(string); ok && v != "" {
+		apiObject.ControlTreatmentName = aws.String(v)
+	}
+
+	if v, ok := tfMap["treatment_weights"].(map[string]interface{}); ok && len(v) > 0 {
+		apiObject.TreatmentWeights = make(map[string]*int64)
+
+		for k, v := range v {
+			apiObject.TreatmentWeights[k] = aws.Int64(int64(v.(int)))
+		}
+	}
+
+	return apiObject
+}
+
+func expandTreatmentConfigs(tfList []interface{}) []*cloudwatchevidently.TreatmentConfig {
+	var apiObjects []*cloudwatchevidently.TreatmentConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &cloudwatchevidently.TreatmentConfig{
+			Feature:   aws.String(tfMap["feature"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+			Variation: aws.String(tfMap["variation"].(string)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenMetricGoals(apiObjects []*cloudwatchevidently.MetricGoal) ([]interface{}, error) {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		metricDefinition, err := flattenMetricDefinition(apiObject.MetricDefinition)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"desired_change":    aws.StringValue(apiObject.DesiredChange),
+			"metric_definition": metricDefinition,
+		})
+	}
+
+	return tfList, nil
+}
+
+func flattenOnlineAbDefinition(apiObject *cloudwatchevidently.OnlineAbDefinition) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	treatmentWeights := make(map[string]interface{})
+
+	for k, v := range apiObject.TreatmentWeights {
+		treatmentWeights[k] = int(aws.Int64Value(v))
+	}
+
+	return []interface{}{map[string]interface{}{
+		"control_treatment_name": aws.StringValue(apiObject.ControlTreatmentName),
+		"treatment_weights":      treatmentWeights,
+	}}
+}
+
+func flattenTreatments(apiObjects []*cloudwatchevidently.Treatment) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"name":        aws.StringValue(apiObject.Name),
+		}
+
+		flattenFeatureVariations(tfMap, apiObject.FeatureVariations)
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
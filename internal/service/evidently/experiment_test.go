@@ -0,0 +1,178 @@
+package evidently_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfevidently "github.com/hashicorp/terraform-provider-aws/internal/service/evidently"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEvidentlyExperiment_basic(t *testing.T) {
+	var v cloudwatchevidently.Experiment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evidently_experiment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudwatchevidently.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExperimentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentConfig(rName, "Created"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "evidently", regexp.MustCompile(`project/.+/experiment/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "description", "Created"),
+					resource.TestCheckResourceAttr(resourceName, "metric_goals.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_goals.0.desired_change", cloudwatchevidently.ChangeDirectionEnumIncrease),
+					resource.TestCheckResourceAttr(resourceName, "metric_goals.0.metric_definition.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_goals.0.metric_definition.0.entity_id_key", "userDetails.userId"),
+					resource.TestCheckResourceAttr(resourceName, "metric_goals.0.metric_definition.0.name", "metric1"),
+					resource.TestCheckResourceAttr(resourceName, "metric_goals.0.metric_definition.0.value_key", "details.duration"),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "project", "aws_evidently_project.test", "name"),
+					resource.TestCheckResourceAttr(resourceName, "status", cloudwatchevidently.ExperimentStatusCreated),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "treatments.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "type", cloudwatchevidently.ExperimentTypeAwsEvidentlyOnlineab),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccExperimentConfig(rName, "Updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "description", "Updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEvidentlyExperiment_disappears(t *testing.T) {
+	var v cloudwatchevidently.Experiment
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_evidently_experiment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, cloudwatchevidently.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckExperimentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccExperimentConfig(rName, "Created"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckExperimentExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfevidently.ResourceExperiment(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckExperimentExists(n string, v *cloudwatchevidently.Experiment) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No CloudWatch Evidently Experiment ID is set")
+		}
+
+		name, project, err := tfevidently.ExperimentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EvidentlyConn
+
+		output, err := tfevidently.FindExperimentByNameAndProject(context.Background(), conn, name, project)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckExperimentDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).EvidentlyConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_evidently_experiment" {
+			continue
+		}
+
+		name, project, err := tfevidently.ExperimentParseResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfevidently.FindExperimentByNameAndProject(context.Background(), conn, name, project)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("CloudWatch Evidently Experiment %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccExperimentConfig(rName, description string) string {
+	return acctest.ConfigCompose(testAccFeatureVariationsConfig(rName, "off", "on"), fmt.Sprintf(`
+resource "aws_evidently_experiment" "test" {
+  name        = %[1]q
+  project     = aws_evidently_project.test.name
+  description = %[2]q
+
+  metric_goals {
+    metric_definition {
+      entity_id_key = "userDetails.userId"
+      name          = "metric1"
+      value_key     = "details.duration"
+    }
+  }
+
+  treatments {
+    feature   = aws_evidently_feature.test.name
+    name      = "control"
+    variation = "off"
+  }
+
+  treatments {
+    feature   = aws_evidently_feature.test.name
+    name      = "treatment"
+    variation = "on"
+  }
+}
+`, rName, description))
+}
@@ -0,0 +1,529 @@
+package evidently
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceFeature() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceFeatureCreate,
+		ReadContext:   resourceFeatureRead,
+		UpdateContext: resourceFeatureUpdate,
+		DeleteContext: resourceFeatureDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_variation": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validName,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 160),
+			},
+			"entity_overrides": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"evaluation_rules": {
+				Type:     schema.TypeSet,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"evaluation_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(cloudwatchevidently.FeatureEvaluationStrategy_Values(), false),
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"value_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"variations": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validName,
+						},
+						"value": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bool_value": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice([]string{"true", "false"}, false),
+									},
+									"double_value": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"long_value": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+									"string_value": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(0, 512),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceFeatureCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	project := d.Get("project").(string)
+
+	variations, err := expandVariationConfigs(d.Get("variations").(*schema.Set).List())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	input := &cloudwatchevidently.CreateFeatureInput{
+		Name:       aws.String(name),
+		Project:    aws.String(project),
+		Variations: variations,
+	}
+
+	if v, ok := d.GetOk("default_variation"); ok {
+		input.DefaultVariation = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("entity_overrides"); ok && len(v.(map[string]interface{})) > 0 {
+		input.EntityOverrides = flex.ExpandStringMap(v.(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("evaluation_strategy"); ok {
+		input.EvaluationStrategy = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Evidently Feature: %s", input)
+	_, err = conn.CreateFeatureWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating CloudWatch Evidently Feature (%s): %s", name, err)
+	}
+
+	d.SetId(featureCreateResourceID(name, project))
+
+	if _, err := waitFeatureUpdated(ctx, conn, name, project); err != nil {
+		return diag.Errorf("error waiting for CloudWatch Evidently Feature (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceFeatureRead(ctx, d, meta)
+}
+
+func resourceFeatureRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name, project, err := FeatureParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	feature, err := FindFeatureByNameAndProject(ctx, conn, name, project)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Evidently Feature (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading CloudWatch Evidently Feature (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", feature.Arn)
+	d.Set("created_time", aws.TimeValue(feature.CreatedTime).Format(time.RFC3339))
+	d.Set("default_variation", feature.DefaultVariation)
+	d.Set("description", feature.Description)
+	d.Set("entity_overrides", aws.StringValueMap(feature.EntityOverrides))
+
+	if err := d.Set("evaluation_rules", flattenEvaluationRules(feature.EvaluationRules)); err != nil {
+		return diag.Errorf("error setting evaluation_rules: %s", err)
+	}
+
+	d.Set("evaluation_strategy", feature.EvaluationStrategy)
+	d.Set("last_updated_time", aws.TimeValue(feature.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("name", feature.Name)
+	d.Set("project", project)
+	d.Set("status", feature.Status)
+	d.Set("value_type", feature.ValueType)
+
+	if err := d.Set("variations", flattenVariations(feature.Variations)); err != nil {
+		return diag.Errorf("error setting variations: %s", err)
+	}
+
+	tags := KeyValueTags(feature.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceFeatureUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		name, project, err := FeatureParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &cloudwatchevidently.UpdateFeatureInput{
+			Description:     aws.String(d.Get("description").(string)),
+			EntityOverrides: flex.ExpandStringMap(d.Get("entity_overrides").(map[string]interface{})),
+			Feature:         aws.String(name),
+			Project:         aws.String(project),
+		}
+
+		if d.HasChange("default_variation") {
+			input.DefaultVariation = aws.String(d.Get("default_variation").(string))
+		}
+
+		if d.HasChange("evaluation_strategy") {
+			input.EvaluationStrategy = aws.String(d.Get("evaluation_strategy").(string))
+		}
+
+		if d.HasChange("variations") {
+			o, n := d.GetChange("variations")
+			os, ns := o.(*schema.Set), n.(*schema.Set)
+
+			add, err := expandVariationConfigs(ns.Difference(os).List())
+
+			if err != nil {
+				return diag.FromErr(err)
+			}
+
+			input.AddOrUpdateVariations = add
+			input.RemoveVariations = removedVariationNames(os.List(), ns.List())
+		}
+
+		log.Printf("[DEBUG] Updating CloudWatch Evidently Feature: %s", input)
+		_, err = conn.UpdateFeatureWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating CloudWatch Evidently Feature (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitFeatureUpdated(ctx, conn, name, project); err != nil {
+			return diag.Errorf("error waiting for CloudWatch Evidently Feature (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating CloudWatch Evidently Feature (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceFeatureRead(ctx, d, meta)
+}
+
+func resourceFeatureDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+
+	name, project, err := FeatureParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting CloudWatch Evidently Feature: %s", d.Id())
+	_, err = conn.DeleteFeatureWithContext(ctx, &cloudwatchevidently.DeleteFeatureInput{
+		Feature: aws.String(name),
+		Project: aws.String(project),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchevidently.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting CloudWatch Evidently Feature (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitFeatureDeleted(ctx, conn, name, project); err != nil {
+		return diag.Errorf("error waiting for CloudWatch Evidently Feature (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandVariationConfigs(tfList []interface{}) ([]*cloudwatchevidently.VariationConfig, error) {
+	var apiObjects []*cloudwatchevidently.VariationConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		name := tfMap["name"].(string)
+		apiObject := &cloudwatchevidently.VariationConfig{
+			Name: aws.String(name),
+		}
+
+		if v, ok := tfMap["value"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+			value, err := expandVariableValue(v[0].(map[string]interface{}))
+
+			if err != nil {
+				return nil, fmt.Errorf("variation (%s): %w", name, err)
+			}
+
+			apiObject.Value = value
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects, nil
+}
+
+// expandVariableValue converts the value union, whose members are all
+// configured as strings, into the typed API object. Exactly one member
+// must be set.
+func expandVariableValue(tfMap map[string]interface{}) (*cloudwatchevidently.VariableValue, error) {
+	apiObject := &cloudwatchevidently.VariableValue{}
+	n := 0
+
+	if v, ok := tfMap["bool_value"].(string); ok && v != "" {
+		b, err := strconv.ParseBool(v)
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject.BoolValue = aws.Bool(b)
+		n++
+	}
+
+	if v, ok := tfMap["double_value"].(string); ok && v != "" {
+		f, err := strconv.ParseFloat(v, 64)
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject.DoubleValue = aws.Float64(f)
+		n++
+	}
+
+	if v, ok := tfMap["long_value"].(string); ok && v != "" {
+		i, err := strconv.ParseInt(v, 10, 64)
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObject.LongValue = aws.Int64(i)
+		n++
+	}
+
+	if v, ok := tfMap["string_value"].(string); ok && v != "" {
+		apiObject.StringValue = aws.String(v)
+		n++
+	}
+
+	if n != 1 {
+		return nil, fmt.Errorf("exactly one of bool_value, double_value, long_value or string_value must be set")
+	}
+
+	return apiObject, nil
+}
+
+func removedVariationNames(oldList, newList []interface{}) []*string {
+	names := make(map[string]struct{})
+
+	for _, tfMapRaw := range newList {
+		if tfMap, ok := tfMapRaw.(map[string]interface{}); ok {
+			names[tfMap["name"].(string)] = struct{}{}
+		}
+	}
+
+	var removed []*string
+
+	for _, tfMapRaw := range oldList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if name := tfMap["name"].(string); name != "" {
+			if _, ok := names[name]; !ok {
+				removed = append(removed, aws.String(name))
+			}
+		}
+	}
+
+	return removed
+}
+
+func flattenEvaluationRules(apiObjects []*cloudwatchevidently.EvaluationRule) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+			"type": aws.StringValue(apiObject.Type),
+		})
+	}
+
+	return tfList
+}
+
+func flattenVariations(apiObjects []*cloudwatchevidently.Variation) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"name": aws.StringValue(apiObject.Name),
+		}
+
+		if v := apiObject.Value; v != nil {
+			value := map[string]interface{}{}
+
+			if v.BoolValue != nil {
+				value["bool_value"] = strconv.FormatBool(aws.BoolValue(v.BoolValue))
+			}
+
+			if v.DoubleValue != nil {
+				value["double_value"] = strconv.FormatFloat(aws.Float64Value(v.DoubleValue), 'f', -1, 64)
+			}
+
+			if v.LongValue != nil {
+				value["long_value"] = strconv.FormatInt(aws.Int64Value(v.LongValue), 10)
+			}
+
+			if v.StringValue != nil {
+				value["string_value"] = aws.StringValue(v.StringValue)
+			}
+
+			tfMap["value"] = []interface{}{value}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,114 @@
+package evidently
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindExperimentByNameAndProject(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, experimentName, projectNameOrARN string) (*cloudwatchevidently.Experiment, error) {
+	input := &cloudwatchevidently.GetExperimentInput{
+		Experiment: aws.String(experimentName),
+		Project:    aws.String(projectNameOrARN),
+	}
+
+	output, err := conn.GetExperimentWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchevidently.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Experiment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Experiment, nil
+}
+
+func FindFeatureByNameAndProject(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, featureName, projectNameOrARN string) (*cloudwatchevidently.Feature, error) {
+	input := &cloudwatchevidently.GetFeatureInput{
+		Feature: aws.String(featureName),
+		Project: aws.String(projectNameOrARN),
+	}
+
+	output, err := conn.GetFeatureWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchevidently.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Feature == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Feature, nil
+}
+
+func FindLaunchByNameAndProject(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, launchName, projectNameOrARN string) (*cloudwatchevidently.Launch, error) {
+	input := &cloudwatchevidently.GetLaunchInput{
+		Launch:  aws.String(launchName),
+		Project: aws.String(projectNameOrARN),
+	}
+
+	output, err := conn.GetLaunchWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchevidently.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Launch == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Launch, nil
+}
+
+func FindProjectByNameOrARN(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, nameOrARN string) (*cloudwatchevidently.Project, error) {
+	input := &cloudwatchevidently.GetProjectInput{
+		Project: aws.String(nameOrARN),
+	}
+
+	output, err := conn.GetProjectWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchevidently.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Project == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Project, nil
+}
@@ -0,0 +1,51 @@
+package evidently
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Projects may be referenced by ARN, which contains colons, so the project
+// is always the last part of a resource ID.
+const resourceIDSeparator = ":"
+
+func experimentCreateResourceID(experimentName, projectNameOrARN string) string {
+	return createResourceID(experimentName, projectNameOrARN)
+}
+
+func ExperimentParseResourceID(id string) (string, string, error) {
+	return parseResourceID(id, "Experiment")
+}
+
+func featureCreateResourceID(featureName, projectNameOrARN string) string {
+	return createResourceID(featureName, projectNameOrARN)
+}
+
+func FeatureParseResourceID(id string) (string, string, error) {
+	return parseResourceID(id, "Feature")
+}
+
+func launchCreateResourceID(launchName, projectNameOrARN string) string {
+	return createResourceID(launchName, projectNameOrARN)
+}
+
+func LaunchParseResourceID(id string) (string, string, error) {
+	return parseResourceID(id, "Launch")
+}
+
+func createResourceID(name, projectNameOrARN string) string {
+	parts := []string{name, projectNameOrARN}
+	id := strings.Join(parts, resourceIDSeparator)
+
+	return id
+}
+
+func parseResourceID(id, resourceType string) (string, string, error) {
+	parts := strings.SplitN(id, resourceIDSeparator, 2)
+
+	if len(parts) == 2 && parts[0] != "" && parts[1] != "" {
+		return parts[0], parts[1], nil
+	}
+
+	return "", "", fmt.Errorf("unexpected format for CloudWatch Evidently %[1]s ID (%[2]s), expected NAME%[3]sPROJECT_NAME_OR_ARN", resourceType, id, resourceIDSeparator)
+}
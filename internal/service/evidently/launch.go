@@ -0,0 +1,678 @@
+package evidently
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceLaunch() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceLaunchCreate,
+		ReadContext:   resourceLaunchRead,
+		UpdateContext: resourceLaunchUpdate,
+		DeleteContext: resourceLaunchDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 160),
+			},
+			"execution": executionSchema(),
+			"groups": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 5,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"description": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validation.StringLenBetween(0, 160),
+						},
+						"feature": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validName,
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validName,
+						},
+						"variation": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validName,
+						},
+					},
+				},
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"metric_monitors": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 3,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"metric_definition": metricDefinitionSchema(),
+					},
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"project": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"randomization_salt": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringLenBetween(0, 127),
+			},
+			"scheduled_splits_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"steps": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							MaxItems: 6,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"group_weights": {
+										Type:     schema.TypeMap,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:         schema.TypeInt,
+											ValidateFunc: validation.IntBetween(0, 100_000),
+										},
+									},
+									"start_time": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.IsRFC3339Time,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_reason": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// executionSchema is shared by launches and experiments.
+func executionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ended_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"started_time": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// metricDefinitionSchema is shared by launch metric monitors and experiment metric goals.
+func metricDefinitionSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MinItems: 1,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"entity_id_key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+				"event_pattern": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ValidateFunc:     validation.StringIsJSON,
+					DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+					StateFunc: func(v interface{}) string {
+						json, _ := structure.NormalizeJsonString(v)
+						return json
+					},
+				},
+				"name": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 255),
+				},
+				"unit_label": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+				"value_key": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringLenBetween(1, 256),
+				},
+			},
+		},
+	}
+}
+
+func resourceLaunchCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	project := d.Get("project").(string)
+	input := &cloudwatchevidently.CreateLaunchInput{
+		Groups:  expandLaunchGroupConfigs(d.Get("groups").([]interface{})),
+		Name:    aws.String(name),
+		Project: aws.String(project),
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("metric_monitors"); ok && len(v.([]interface{})) > 0 {
+		metricMonitors, err := expandMetricMonitorConfigs(v.([]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input.MetricMonitors = metricMonitors
+	}
+
+	if v, ok := d.GetOk("randomization_salt"); ok {
+		input.RandomizationSalt = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("scheduled_splits_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ScheduledSplitsConfig = expandScheduledSplitsLaunchConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Evidently Launch: %s", input)
+	_, err := conn.CreateLaunchWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating CloudWatch Evidently Launch (%s): %s", name, err)
+	}
+
+	d.SetId(launchCreateResourceID(name, project))
+
+	if _, err := waitLaunchUpdated(ctx, conn, name, project); err != nil {
+		return diag.Errorf("error waiting for CloudWatch Evidently Launch (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceLaunchRead(ctx, d, meta)
+}
+
+func resourceLaunchRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	name, project, err := LaunchParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	launch, err := FindLaunchByNameAndProject(ctx, conn, name, project)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Evidently Launch (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading CloudWatch Evidently Launch (%s): %s", d.Id(), err)
+	}
+
+	d.Set("arn", launch.Arn)
+	d.Set("created_time", aws.TimeValue(launch.CreatedTime).Format(time.RFC3339))
+	d.Set("description", launch.Description)
+
+	if v := launch.Execution; v != nil {
+		if err := d.Set("execution", flattenExecution(v.StartedTime, v.EndedTime)); err != nil {
+			return diag.Errorf("error setting execution: %s", err)
+		}
+	} else {
+		d.Set("execution", nil)
+	}
+
+	if err := d.Set("groups", flattenLaunchGroups(launch.Groups)); err != nil {
+		return diag.Errorf("error setting groups: %s", err)
+	}
+
+	d.Set("last_updated_time", aws.TimeValue(launch.LastUpdatedTime).Format(time.RFC3339))
+
+	metricMonitors, err := flattenMetricMonitors(launch.MetricMonitors)
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("metric_monitors", metricMonitors); err != nil {
+		return diag.Errorf("error setting metric_monitors: %s", err)
+	}
+
+	d.Set("name", launch.Name)
+	d.Set("project", project)
+	d.Set("randomization_salt", launch.RandomizationSalt)
+
+	if err := d.Set("scheduled_splits_config", flattenScheduledSplitsLaunchDefinition(launch.ScheduledSplitsDefinition)); err != nil {
+		return diag.Errorf("error setting scheduled_splits_config: %s", err)
+	}
+
+	d.Set("status", launch.Status)
+	d.Set("status_reason", launch.StatusReason)
+	d.Set("type", launch.Type)
+
+	tags := KeyValueTags(launch.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceLaunchUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		name, project, err := LaunchParseResourceID(d.Id())
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		metricMonitors, err := expandMetricMonitorConfigs(d.Get("metric_monitors").([]interface{}))
+
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		input := &cloudwatchevidently.UpdateLaunchInput{
+			Description:    aws.String(d.Get("description").(string)),
+			Groups:         expandLaunchGroupConfigs(d.Get("groups").([]interface{})),
+			Launch:         aws.String(name),
+			MetricMonitors: metricMonitors,
+			Project:        aws.String(project),
+		}
+
+		if d.HasChange("randomization_salt") {
+			input.RandomizationSalt = aws.String(d.Get("randomization_salt").(string))
+		}
+
+		if v, ok := d.GetOk("scheduled_splits_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ScheduledSplitsConfig = expandScheduledSplitsLaunchConfig(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		log.Printf("[DEBUG] Updating CloudWatch Evidently Launch: %s", input)
+		_, err = conn.UpdateLaunchWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating CloudWatch Evidently Launch (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitLaunchUpdated(ctx, conn, name, project); err != nil {
+			return diag.Errorf("error waiting for CloudWatch Evidently Launch (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating CloudWatch Evidently Launch (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceLaunchRead(ctx, d, meta)
+}
+
+func resourceLaunchDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+
+	name, project, err := LaunchParseResourceID(d.Id())
+
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Deleting CloudWatch Evidently Launch: %s", d.Id())
+	_, err = conn.DeleteLaunchWithContext(ctx, &cloudwatchevidently.DeleteLaunchInput{
+		Launch:  aws.String(name),
+		Project: aws.String(project),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchevidently.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting CloudWatch Evidently Launch (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitLaunchDeleted(ctx, conn, name, project); err != nil {
+		return diag.Errorf("error waiting for CloudWatch Evidently Launch (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandLaunchGroupConfigs(tfList []interface{}) []*cloudwatchevidently.LaunchGroupConfig {
+	var apiObjects []*cloudwatchevidently.LaunchGroupConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &cloudwatchevidently.LaunchGroupConfig{
+			Feature:   aws.String(tfMap["feature"].(string)),
+			Name:      aws.String(tfMap["name"].(string)),
+			Variation: aws.String(tfMap["variation"].(string)),
+		}
+
+		if v, ok := tfMap["description"].(string); ok && v != "" {
+			apiObject.Description = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandMetricDefinitionConfig(tfList []interface{}) (*cloudwatchevidently.MetricDefinitionConfig, error) {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil, nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &cloudwatchevidently.MetricDefinitionConfig{
+		EntityIdKey: aws.String(tfMap["entity_id_key"].(string)),
+		Name:        aws.String(tfMap["name"].(string)),
+		ValueKey:    aws.String(tfMap["value_key"].(string)),
+	}
+
+	if v, ok := tfMap["event_pattern"].(string); ok && v != "" {
+		var eventPattern aws.JSONValue
+
+		if err := json.Unmarshal([]byte(v), &eventPattern); err != nil {
+			return nil, fmt.Errorf("error decoding event_pattern (%s): %w", v, err)
+		}
+
+		apiObject.EventPattern = eventPattern
+	}
+
+	if v, ok := tfMap["unit_label"].(string); ok && v != "" {
+		apiObject.UnitLabel = aws.String(v)
+	}
+
+	return apiObject, nil
+}
+
+func expandMetricMonitorConfigs(tfList []interface{}) ([]*cloudwatchevidently.MetricMonitorConfig, error) {
+	var apiObjects []*cloudwatchevidently.MetricMonitorConfig
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		metricDefinition, err := expandMetricDefinitionConfig(tfMap["metric_definition"].([]interface{}))
+
+		if err != nil {
+			return nil, err
+		}
+
+		apiObjects = append(apiObjects, &cloudwatchevidently.MetricMonitorConfig{
+			MetricDefinition: metricDefinition,
+		})
+	}
+
+	return apiObjects, nil
+}
+
+func expandScheduledSplitsLaunchConfig(tfMap map[string]interface{}) *cloudwatchevidently.ScheduledSplitsLaunchConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudwatchevidently.ScheduledSplitsLaunchConfig{}
+
+	for _, tfMapRaw := range tfMap["steps"].([]interface{}) {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		step := &cloudwatchevidently.ScheduledSplitConfig{
+			GroupWeights: make(map[string]*int64),
+		}
+
+		for k, v := range tfMap["group_weights"].(map[string]interface{}) {
+			step.GroupWeights[k] = aws.Int64(int64(v.(int)))
+		}
+
+		if v, ok := tfMap["start_time"].(string); ok && v != "" {
+			t, _ := time.Parse(time.RFC3339, v)
+			step.StartTime = aws.Time(t)
+		}
+
+		apiObject.Steps = append(apiObject.Steps, step)
+	}
+
+	return apiObject
+}
+
+func flattenExecution(startedTime, endedTime *time.Time) []interface{} {
+	tfMap := map[string]interface{}{}
+
+	if startedTime != nil {
+		tfMap["started_time"] = aws.TimeValue(startedTime).Format(time.RFC3339)
+	}
+
+	if endedTime != nil {
+		tfMap["ended_time"] = aws.TimeValue(endedTime).Format(time.RFC3339)
+	}
+
+	return []interface{}{tfMap}
+}
+
+// flattenFeatureVariations unpacks the single feature/variation pair of a launch group or experiment treatment.
+func flattenFeatureVariations(tfMap map[string]interface{}, apiObject map[string]*string) {
+	for k, v := range apiObject {
+		tfMap["feature"] = k
+		tfMap["variation"] = aws.StringValue(v)
+	}
+}
+
+func flattenLaunchGroups(apiObjects []*cloudwatchevidently.LaunchGroup) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfMap := map[string]interface{}{
+			"description": aws.StringValue(apiObject.Description),
+			"name":        aws.StringValue(apiObject.Name),
+		}
+
+		flattenFeatureVariations(tfMap, apiObject.FeatureVariations)
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenMetricDefinition(apiObject *cloudwatchevidently.MetricDefinition) ([]interface{}, error) {
+	if apiObject == nil {
+		return nil, nil
+	}
+
+	tfMap := map[string]interface{}{
+		"entity_id_key": aws.StringValue(apiObject.EntityIdKey),
+		"name":          aws.StringValue(apiObject.Name),
+		"unit_label":    aws.StringValue(apiObject.UnitLabel),
+		"value_key":     aws.StringValue(apiObject.ValueKey),
+	}
+
+	if v := apiObject.EventPattern; v != nil {
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			return nil, fmt.Errorf("error encoding event_pattern: %w", err)
+		}
+
+		eventPattern, err := structure.NormalizeJsonString(string(b))
+
+		if err != nil {
+			return nil, err
+		}
+
+		tfMap["event_pattern"] = eventPattern
+	}
+
+	return []interface{}{tfMap}, nil
+}
+
+func flattenMetricMonitors(apiObjects []*cloudwatchevidently.MetricMonitor) ([]interface{}, error) {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		metricDefinition, err := flattenMetricDefinition(apiObject.MetricDefinition)
+
+		if err != nil {
+			return nil, err
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"metric_definition": metricDefinition,
+		})
+	}
+
+	return tfList, nil
+}
+
+func flattenScheduledSplitsLaunchDefinition(apiObject *cloudwatchevidently.ScheduledSplitsLaunchDefinition) []interface{} {
+	if apiObject == nil || len(apiObject.Steps) == 0 {
+		return nil
+	}
+
+	var steps []interface{}
+
+	for _, v := range apiObject.Steps {
+		if v == nil {
+			continue
+		}
+
+		groupWeights := make(map[string]interface{})
+
+		for k, v := range v.GroupWeights {
+			groupWeights[k] = int(aws.Int64Value(v))
+		}
+
+		steps = append(steps, map[string]interface{}{
+			"group_weights": groupWeights,
+			"start_time":    aws.TimeValue(v.StartTime).Format(time.RFC3339),
+		})
+	}
+
+	return []interface{}{map[string]interface{}{
+		"steps": steps,
+	}}
+}
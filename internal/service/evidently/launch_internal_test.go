@@ -0,0 +1,83 @@
+package evidently
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+)
+
+func TestMetricDefinitionEventPatternRoundTrip(t *testing.T) {
+	testCases := []struct {
+		TestName      string
+		EventPattern  string
+		ExpectedError bool
+	}{
+		{
+			TestName: "no event pattern",
+		},
+		{
+			TestName:     "normalized",
+			EventPattern: `{"eventType":["Click"],"details":{"value":[{"numeric":[">",0]}]}}`,
+		},
+		{
+			TestName: "whitespace",
+			EventPattern: `{
+  "eventType": ["Click"]
+}`,
+		},
+		{
+			TestName:      "invalid JSON",
+			EventPattern:  `{"eventType":`,
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			config, err := expandMetricDefinitionConfig([]interface{}{map[string]interface{}{
+				"entity_id_key": "userDetails.userId",
+				"event_pattern": testCase.EventPattern,
+				"name":          "test",
+				"unit_label":    "",
+				"value_key":     "details.value",
+			}})
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if testCase.ExpectedError {
+				return
+			}
+
+			tfList, err := flattenMetricDefinition(&cloudwatchevidently.MetricDefinition{
+				EntityIdKey:  config.EntityIdKey,
+				EventPattern: config.EventPattern,
+				Name:         config.Name,
+				UnitLabel:    config.UnitLabel,
+				ValueKey:     config.ValueKey,
+			})
+
+			if err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			got, _ := tfList[0].(map[string]interface{})["event_pattern"].(string)
+
+			// The read value must match what the schema's StateFunc stores for the configured value.
+			var expected string
+			if testCase.EventPattern != "" {
+				expected, _ = structure.NormalizeJsonString(testCase.EventPattern)
+			}
+
+			if got != expected {
+				t.Errorf("got event_pattern %s, expected %s", got, expected)
+			}
+		})
+	}
+}
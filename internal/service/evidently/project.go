@@ -0,0 +1,362 @@
+package evidently
+
+import (
+	"context"
+	"log"
+	"regexp"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceProject() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceProjectCreate,
+		ReadContext:   resourceProjectRead,
+		UpdateContext: resourceProjectUpdate,
+		DeleteContext: resourceProjectDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"active_experiment_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"active_launch_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_delivery": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cloudwatch_logs": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"data_delivery.0.s3_destination"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"log_group": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ValidateFunc: validation.All(
+											validation.StringLenBetween(1, 512),
+											validation.StringMatch(regexp.MustCompile(`^[-a-zA-Z0-9._/]+$`), "must be a valid CloudWatch Logs log group name"),
+										),
+									},
+								},
+							},
+						},
+						"s3_destination": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"data_delivery.0.cloudwatch_logs"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"bucket": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(3, 63),
+									},
+									"prefix": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringLenBetween(1, 1024),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 160),
+			},
+			"experiment_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"feature_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"last_updated_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"launch_count": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validName,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceProjectCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &cloudwatchevidently.CreateProjectInput{
+		Name: aws.String(name),
+	}
+
+	if v, ok := d.GetOk("data_delivery"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.DataDelivery = expandProjectDataDeliveryConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("description"); ok {
+		input.Description = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating CloudWatch Evidently Project: %s", input)
+	output, err := conn.CreateProjectWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error creating CloudWatch Evidently Project (%s): %s", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Project.Name))
+
+	if _, err := waitProjectUpdated(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("error waiting for CloudWatch Evidently Project (%s) create: %s", d.Id(), err)
+	}
+
+	return resourceProjectRead(ctx, d, meta)
+}
+
+func resourceProjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	project, err := FindProjectByNameOrARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] CloudWatch Evidently Project (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading CloudWatch Evidently Project (%s): %s", d.Id(), err)
+	}
+
+	d.Set("active_experiment_count", project.ActiveExperimentCount)
+	d.Set("active_launch_count", project.ActiveLaunchCount)
+	d.Set("arn", project.Arn)
+	d.Set("created_time", aws.TimeValue(project.CreatedTime).Format(time.RFC3339))
+
+	if err := d.Set("data_delivery", flattenProjectDataDelivery(project.DataDelivery)); err != nil {
+		return diag.Errorf("error setting data_delivery: %s", err)
+	}
+
+	d.Set("description", project.Description)
+	d.Set("experiment_count", project.ExperimentCount)
+	d.Set("feature_count", project.FeatureCount)
+	d.Set("last_updated_time", aws.TimeValue(project.LastUpdatedTime).Format(time.RFC3339))
+	d.Set("launch_count", project.LaunchCount)
+	d.Set("name", project.Name)
+	d.Set("status", project.Status)
+
+	tags := KeyValueTags(project.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return diag.Errorf("error setting tags: %s", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return diag.Errorf("error setting tags_all: %s", err)
+	}
+
+	return nil
+}
+
+func resourceProjectUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+
+	if d.HasChange("description") {
+		input := &cloudwatchevidently.UpdateProjectInput{
+			Description: aws.String(d.Get("description").(string)),
+			Project:     aws.String(d.Id()),
+		}
+
+		log.Printf("[DEBUG] Updating CloudWatch Evidently Project: %s", input)
+		_, err := conn.UpdateProjectWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating CloudWatch Evidently Project (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitProjectUpdated(ctx, conn, d.Id()); err != nil {
+			return diag.Errorf("error waiting for CloudWatch Evidently Project (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	// Data delivery is updated through a separate API.
+	if d.HasChange("data_delivery") {
+		input := &cloudwatchevidently.UpdateProjectDataDeliveryInput{
+			Project: aws.String(d.Id()),
+		}
+
+		if v, ok := d.GetOk("data_delivery"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			apiObject := expandProjectDataDeliveryConfig(v.([]interface{})[0].(map[string]interface{}))
+			input.CloudWatchLogs = apiObject.CloudWatchLogs
+			input.S3Destination = apiObject.S3Destination
+		}
+
+		// Without a destination, evaluation events are no longer delivered.
+		if input.CloudWatchLogs == nil && input.S3Destination == nil {
+			input.CloudWatchLogs = &cloudwatchevidently.CloudWatchLogsDestinationConfig{}
+		}
+
+		log.Printf("[DEBUG] Updating CloudWatch Evidently Project data delivery: %s", input)
+		_, err := conn.UpdateProjectDataDeliveryWithContext(ctx, input)
+
+		if err != nil {
+			return diag.Errorf("error updating CloudWatch Evidently Project (%s) data delivery: %s", d.Id(), err)
+		}
+
+		if _, err := waitProjectUpdated(ctx, conn, d.Id()); err != nil {
+			return diag.Errorf("error waiting for CloudWatch Evidently Project (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return diag.Errorf("error updating CloudWatch Evidently Project (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	return resourceProjectRead(ctx, d, meta)
+}
+
+func resourceProjectDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).EvidentlyConn
+
+	log.Printf("[DEBUG] Deleting CloudWatch Evidently Project: %s", d.Id())
+	_, err := conn.DeleteProjectWithContext(ctx, &cloudwatchevidently.DeleteProjectInput{
+		Project: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, cloudwatchevidently.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error deleting CloudWatch Evidently Project (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitProjectDeleted(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("error waiting for CloudWatch Evidently Project (%s) delete: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandProjectDataDeliveryConfig(tfMap map[string]interface{}) *cloudwatchevidently.ProjectDataDeliveryConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &cloudwatchevidently.ProjectDataDeliveryConfig{}
+
+	if v, ok := tfMap["cloudwatch_logs"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CloudWatchLogs = &cloudwatchevidently.CloudWatchLogsDestinationConfig{}
+
+		if v, ok := v[0].(map[string]interface{})["log_group"].(string); ok && v != "" {
+			apiObject.CloudWatchLogs.LogGroup = aws.String(v)
+		}
+	}
+
+	if v, ok := tfMap["s3_destination"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.S3Destination = &cloudwatchevidently.S3DestinationConfig{}
+
+		if v, ok := tfMap["bucket"].(string); ok && v != "" {
+			apiObject.S3Destination.Bucket = aws.String(v)
+		}
+
+		if v, ok := tfMap["prefix"].(string); ok && v != "" {
+			apiObject.S3Destination.Prefix = aws.String(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenProjectDataDelivery(apiObject *cloudwatchevidently.ProjectDataDelivery) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	// An empty destination means data delivery is disabled.
+	if v := apiObject.CloudWatchLogs; v != nil && aws.StringValue(v.LogGroup) != "" {
+		tfMap["cloudwatch_logs"] = []interface{}{map[string]interface{}{
+			"log_group": aws.StringValue(v.LogGroup),
+		}}
+	}
+
+	if v := apiObject.S3Destination; v != nil && aws.StringValue(v.Bucket) != "" {
+		tfMap["s3_destination"] = []interface{}{map[string]interface{}{
+			"bucket": aws.StringValue(v.Bucket),
+			"prefix": aws.StringValue(v.Prefix),
+		}}
+	}
+
+	if len(tfMap) == 0 {
+		return nil
+	}
+
+	return []interface{}{tfMap}
+}
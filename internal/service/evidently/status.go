@@ -0,0 +1,74 @@
+package evidently
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusExperiment(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, experimentName, projectNameOrARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindExperimentByNameAndProject(ctx, conn, experimentName, projectNameOrARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusFeature(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, featureName, projectNameOrARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindFeatureByNameAndProject(ctx, conn, featureName, projectNameOrARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusLaunch(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, launchName, projectNameOrARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindLaunchByNameAndProject(ctx, conn, launchName, projectNameOrARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
+
+func statusProject(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, nameOrARN string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindProjectByNameOrARN(ctx, conn, nameOrARN)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
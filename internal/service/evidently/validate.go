@@ -0,0 +1,12 @@
+package evidently
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+var validName = validation.All(
+	validation.StringLenBetween(1, 127),
+	validation.StringMatch(regexp.MustCompile(`^[-a-zA-Z0-9._]+$`), "must contain only alphanumeric characters, hyphens, periods and underscores"),
+)
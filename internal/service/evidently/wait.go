@@ -0,0 +1,172 @@
+package evidently
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/cloudwatchevidently"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	experimentTimeout = 2 * time.Minute
+	featureTimeout    = 2 * time.Minute
+	launchTimeout     = 2 * time.Minute
+	projectTimeout    = 2 * time.Minute
+)
+
+func waitExperimentUpdated(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, experimentName, projectNameOrARN string) (*cloudwatchevidently.Experiment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudwatchevidently.ExperimentStatusUpdating},
+		Target: []string{
+			cloudwatchevidently.ExperimentStatusCancelled,
+			cloudwatchevidently.ExperimentStatusCompleted,
+			cloudwatchevidently.ExperimentStatusCreated,
+			cloudwatchevidently.ExperimentStatusRunning,
+		},
+		Refresh: statusExperiment(ctx, conn, experimentName, projectNameOrARN),
+		Timeout: experimentTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchevidently.Experiment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitExperimentDeleted(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, experimentName, projectNameOrARN string) (*cloudwatchevidently.Experiment, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudwatchevidently.ExperimentStatusCancelled,
+			cloudwatchevidently.ExperimentStatusCompleted,
+			cloudwatchevidently.ExperimentStatusCreated,
+			cloudwatchevidently.ExperimentStatusUpdating,
+		},
+		Target:  []string{},
+		Refresh: statusExperiment(ctx, conn, experimentName, projectNameOrARN),
+		Timeout: experimentTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchevidently.Experiment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFeatureUpdated(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, featureName, projectNameOrARN string) (*cloudwatchevidently.Feature, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudwatchevidently.FeatureStatusUpdating},
+		Target:  []string{cloudwatchevidently.FeatureStatusAvailable},
+		Refresh: statusFeature(ctx, conn, featureName, projectNameOrARN),
+		Timeout: featureTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchevidently.Feature); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitFeatureDeleted(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, featureName, projectNameOrARN string) (*cloudwatchevidently.Feature, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudwatchevidently.FeatureStatusAvailable, cloudwatchevidently.FeatureStatusUpdating},
+		Target:  []string{},
+		Refresh: statusFeature(ctx, conn, featureName, projectNameOrARN),
+		Timeout: featureTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchevidently.Feature); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitLaunchUpdated(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, launchName, projectNameOrARN string) (*cloudwatchevidently.Launch, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudwatchevidently.LaunchStatusUpdating},
+		Target: []string{
+			cloudwatchevidently.LaunchStatusCancelled,
+			cloudwatchevidently.LaunchStatusCompleted,
+			cloudwatchevidently.LaunchStatusCreated,
+			cloudwatchevidently.LaunchStatusRunning,
+		},
+		Refresh: statusLaunch(ctx, conn, launchName, projectNameOrARN),
+		Timeout: launchTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchevidently.Launch); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitLaunchDeleted(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, launchName, projectNameOrARN string) (*cloudwatchevidently.Launch, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			cloudwatchevidently.LaunchStatusCancelled,
+			cloudwatchevidently.LaunchStatusCompleted,
+			cloudwatchevidently.LaunchStatusCreated,
+			cloudwatchevidently.LaunchStatusUpdating,
+		},
+		Target:  []string{},
+		Refresh: statusLaunch(ctx, conn, launchName, projectNameOrARN),
+		Timeout: launchTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchevidently.Launch); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectUpdated(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, nameOrARN string) (*cloudwatchevidently.Project, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudwatchevidently.ProjectStatusUpdating},
+		Target:  []string{cloudwatchevidently.ProjectStatusAvailable},
+		Refresh: statusProject(ctx, conn, nameOrARN),
+		Timeout: projectTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchevidently.Project); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitProjectDeleted(ctx context.Context, conn *cloudwatchevidently.CloudWatchEvidently, nameOrARN string) (*cloudwatchevidently.Project, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{cloudwatchevidently.ProjectStatusAvailable, cloudwatchevidently.ProjectStatusUpdating},
+		Target:  []string{},
+		Refresh: statusProject(ctx, conn, nameOrARN),
+		Timeout: projectTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*cloudwatchevidently.Project); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,469 @@
+package gamelift
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+const (
+	gameServerGroupCreatedTimeout = 10 * time.Minute
+	gameServerGroupDeletedTimeout = 30 * time.Minute
+)
+
+func ResourceGameServerGroup() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceGameServerGroupCreate,
+		Read:   resourceGameServerGroupRead,
+		Update: resourceGameServerGroupUpdate,
+		Delete: resourceGameServerGroupDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(gameServerGroupCreatedTimeout),
+			Delete: schema.DefaultTimeout(gameServerGroupDeletedTimeout),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"auto_scaling_group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"balance_strategy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.BalancingStrategy_Values(), false),
+			},
+			"game_server_group_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"game_server_group_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-\.]+$`), "must contain only alphanumeric characters, hyphens and periods"),
+				),
+			},
+			"game_server_protection_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(gamelift.GameServerProtectionPolicy_Values(), false),
+			},
+			"instance_definitions": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 2,
+				MaxItems: 20,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(gamelift.GameServerGroupInstanceType_Values(), false),
+						},
+						"weighted_capacity": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ValidateFunc: validation.All(
+								validation.StringLenBetween(1, 3),
+								validation.StringMatch(regexp.MustCompile(`^[\d]+$`), "must be a number"),
+							),
+						},
+					},
+				},
+			},
+			"launch_template": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"launch_template.0.id", "launch_template.0.name"},
+						},
+						"name": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ForceNew:     true,
+							ExactlyOneOf: []string{"launch_template.0.id", "launch_template.0.name"},
+						},
+						"version": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"min_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc_subnets": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 20,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringLenBetween(15, 24),
+				},
+			},
+		},
+	}
+}
+
+func resourceGameServerGroupCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("game_server_group_name").(string)
+	input := &gamelift.CreateGameServerGroupInput{
+		GameServerGroupName: aws.String(name),
+		InstanceDefinitions: expandGameliftInstanceDefinitions(d.Get("instance_definitions").([]interface{})),
+		LaunchTemplate:      expandGameliftLaunchTemplateSpecification(d.Get("launch_template").([]interface{})[0].(map[string]interface{})),
+		MaxSize:             aws.Int64(int64(d.Get("max_size").(int))),
+		MinSize:             aws.Int64(int64(d.Get("min_size").(int))),
+		RoleArn:             aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("balance_strategy"); ok {
+		input.BalancingStrategy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("game_server_protection_policy"); ok {
+		input.GameServerProtectionPolicy = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("vpc_subnets"); ok && v.(*schema.Set).Len() > 0 {
+		input.VpcSubnets = flex.ExpandStringSet(v.(*schema.Set))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[INFO] Creating GameLift Game Server Group: %s", input)
+	// The service role may not be assumable by GameLift straight after creation.
+	_, err := tfresource.RetryWhen(
+		tfiam.PropagationTimeout,
+		func() (interface{}, error) {
+			return conn.CreateGameServerGroup(input)
+		},
+		func(err error) (bool, error) {
+			if tfawserr.ErrMessageContains(err, gamelift.ErrCodeInvalidRequestException, "GameLift is not authorized to perform") {
+				return true, err
+			}
+
+			return false, err
+		},
+	)
+
+	if err != nil {
+		return fmt.Errorf("error creating GameLift Game Server Group (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	if _, err := waitGameServerGroupActive(conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return fmt.Errorf("error waiting for GameLift Game Server Group (%s) to become active: %w", d.Id(), err)
+	}
+
+	return resourceGameServerGroupRead(d, meta)
+}
+
+func resourceGameServerGroupRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+	autoscalingConn := meta.(*conns.AWSClient).AutoScalingConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	gameServerGroup, err := FindGameServerGroupByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] GameLift Game Server Group (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading GameLift Game Server Group (%s): %w", d.Id(), err)
+	}
+
+	asgArn := aws.StringValue(gameServerGroup.AutoScalingGroupArn)
+	d.Set("auto_scaling_group_arn", asgArn)
+	d.Set("balance_strategy", gameServerGroup.BalancingStrategy)
+	d.Set("game_server_group_arn", gameServerGroup.GameServerGroupArn)
+	d.Set("game_server_group_name", gameServerGroup.GameServerGroupName)
+	d.Set("game_server_protection_policy", gameServerGroup.GameServerProtectionPolicy)
+
+	if err := d.Set("instance_definitions", flattenGameliftInstanceDefinitions(gameServerGroup.InstanceDefinitions)); err != nil {
+		return fmt.Errorf("error setting instance_definitions: %w", err)
+	}
+
+	d.Set("role_arn", gameServerGroup.RoleArn)
+	d.Set("status", gameServerGroup.Status)
+
+	// The group's size is held by the Auto Scaling group that GameLift manages on our behalf.
+	// launch_template and vpc_subnets are not returned by either service and are kept from configuration.
+	if asgName, err := gameliftAutoScalingGroupNameFromARN(asgArn); err == nil {
+		output, err := autoscalingConn.DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: aws.StringSlice([]string{asgName}),
+		})
+
+		if err != nil {
+			return fmt.Errorf("error reading GameLift Game Server Group (%s) Auto Scaling group (%s): %w", d.Id(), asgName, err)
+		}
+
+		if output != nil && len(output.AutoScalingGroups) > 0 && output.AutoScalingGroups[0] != nil {
+			d.Set("max_size", output.AutoScalingGroups[0].MaxSize)
+			d.Set("min_size", output.AutoScalingGroups[0].MinSize)
+		}
+	}
+
+	arn := aws.StringValue(gameServerGroup.GameServerGroupArn)
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for GameLift Game Server Group (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceGameServerGroupUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+	autoscalingConn := meta.(*conns.AWSClient).AutoScalingConn
+
+	if d.HasChanges("balance_strategy", "game_server_protection_policy", "instance_definitions", "role_arn") {
+		input := &gamelift.UpdateGameServerGroupInput{
+			GameServerGroupName: aws.String(d.Id()),
+			InstanceDefinitions: expandGameliftInstanceDefinitions(d.Get("instance_definitions").([]interface{})),
+			RoleArn:             aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("balance_strategy"); ok {
+			input.BalancingStrategy = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("game_server_protection_policy"); ok {
+			input.GameServerProtectionPolicy = aws.String(v.(string))
+		}
+
+		log.Printf("[INFO] Updating GameLift Game Server Group: %s", input)
+		_, err := conn.UpdateGameServerGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating GameLift Game Server Group (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("max_size", "min_size") {
+		asgArn := d.Get("auto_scaling_group_arn").(string)
+		asgName, err := gameliftAutoScalingGroupNameFromARN(asgArn)
+
+		if err != nil {
+			return err
+		}
+
+		input := &autoscaling.UpdateAutoScalingGroupInput{
+			AutoScalingGroupName: aws.String(asgName),
+			MaxSize:              aws.Int64(int64(d.Get("max_size").(int))),
+			MinSize:              aws.Int64(int64(d.Get("min_size").(int))),
+		}
+
+		log.Printf("[INFO] Updating GameLift Game Server Group (%s) Auto Scaling group: %s", d.Id(), input)
+		_, err = autoscalingConn.UpdateAutoScalingGroup(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating GameLift Game Server Group (%s) Auto Scaling group (%s): %w", d.Id(), asgName, err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		arn := d.Get("game_server_group_arn").(string)
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, arn, o, n); err != nil {
+			return fmt.Errorf("error updating GameLift Game Server Group (%s) tags: %w", arn, err)
+		}
+	}
+
+	return resourceGameServerGroupRead(d, meta)
+}
+
+func resourceGameServerGroupDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).GameLiftConn
+
+	// Stop FleetIQ from swapping instance types in the Auto Scaling group while it is being torn down.
+	log.Printf("[INFO] Suspending GameLift Game Server Group: %s", d.Id())
+	_, err := conn.SuspendGameServerGroup(&gamelift.SuspendGameServerGroupInput{
+		GameServerGroupName: aws.String(d.Id()),
+		SuspendActions:      aws.StringSlice([]string{gamelift.GameServerGroupActionReplaceInstanceTypes}),
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error suspending GameLift Game Server Group (%s): %w", d.Id(), err)
+	}
+
+	log.Printf("[INFO] Deleting GameLift Game Server Group: %s", d.Id())
+	_, err = conn.DeleteGameServerGroup(&gamelift.DeleteGameServerGroupInput{
+		GameServerGroupName: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, gamelift.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting GameLift Game Server Group (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitGameServerGroupDeleted(conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return fmt.Errorf("error waiting for GameLift Game Server Group (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// gameliftAutoScalingGroupNameFromARN extracts the Auto Scaling group name from an ARN of the form
+// arn:aws:autoscaling:region:account:autoScalingGroup:uuid:autoScalingGroupName/name.
+func gameliftAutoScalingGroupNameFromARN(s string) (string, error) {
+	parsedARN, err := arn.Parse(s)
+
+	if err != nil {
+		return "", fmt.Errorf("error parsing Auto Scaling group ARN (%s): %w", s, err)
+	}
+
+	const prefix = "autoScalingGroupName/"
+	i := strings.Index(parsedARN.Resource, prefix)
+
+	if i == -1 {
+		return "", fmt.Errorf("unexpected format for Auto Scaling group ARN (%s)", s)
+	}
+
+	return parsedARN.Resource[i+len(prefix):], nil
+}
+
+func expandGameliftInstanceDefinitions(tfList []interface{}) []*gamelift.InstanceDefinition {
+	var apiObjects []*gamelift.InstanceDefinition
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &gamelift.InstanceDefinition{
+			InstanceType: aws.String(tfMap["instance_type"].(string)),
+		}
+
+		if v, ok := tfMap["weighted_capacity"].(string); ok && v != "" {
+			apiObject.WeightedCapacity = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandGameliftLaunchTemplateSpecification(tfMap map[string]interface{}) *gamelift.LaunchTemplateSpecification {
+	apiObject := &gamelift.LaunchTemplateSpecification{}
+
+	if v, ok := tfMap["id"].(string); ok && v != "" {
+		apiObject.LaunchTemplateId = aws.String(v)
+	}
+
+	if v, ok := tfMap["name"].(string); ok && v != "" {
+		apiObject.LaunchTemplateName = aws.String(v)
+	}
+
+	if v, ok := tfMap["version"].(string); ok && v != "" {
+		apiObject.Version = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenGameliftInstanceDefinitions(apiObjects []*gamelift.InstanceDefinition) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"instance_type":     aws.StringValue(apiObject.InstanceType),
+			"weighted_capacity": aws.StringValue(apiObject.WeightedCapacity),
+		})
+	}
+
+	return tfList
+}
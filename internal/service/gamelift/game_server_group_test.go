@@ -0,0 +1,275 @@
+package gamelift_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/gamelift"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgamelift "github.com/hashicorp/terraform-provider-aws/internal/service/gamelift"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccGameLiftGameServerGroup_basic(t *testing.T) {
+	var conf gamelift.GameServerGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_game_server_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, gamelift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGameServerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGameServerGroupConfig(rName, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGameServerGroupExists(resourceName, &conf),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "auto_scaling_group_arn", "autoscaling", regexp.MustCompile(`autoScalingGroup:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "balance_strategy", gamelift.BalancingStrategySpotPreferred),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "game_server_group_arn", "gamelift", regexp.MustCompile(`gameservergroup/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "game_server_group_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "game_server_protection_policy", gamelift.GameServerProtectionPolicyNoProtection),
+					resource.TestCheckResourceAttr(resourceName, "instance_definitions.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "instance_definitions.0.instance_type", "c5.large"),
+					resource.TestCheckResourceAttr(resourceName, "instance_definitions.1.instance_type", "c5a.large"),
+					resource.TestCheckResourceAttr(resourceName, "launch_template.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template.0.id", "aws_launch_template.test", "id"),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_size", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "status", gamelift.GameServerGroupStatusActive),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"launch_template", "vpc_subnets"},
+			},
+			{
+				Config: testAccGameServerGroupConfig(rName, 0, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGameServerGroupExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "max_size", "3"),
+					resource.TestCheckResourceAttr(resourceName, "min_size", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccGameLiftGameServerGroup_disappears(t *testing.T) {
+	var conf gamelift.GameServerGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_game_server_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, gamelift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGameServerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGameServerGroupConfig(rName, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGameServerGroupExists(resourceName, &conf),
+					acctest.CheckResourceDisappears(acctest.Provider, tfgamelift.ResourceGameServerGroup(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccGameLiftGameServerGroup_settings(t *testing.T) {
+	var conf gamelift.GameServerGroup
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_gamelift_game_server_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(gamelift.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, gamelift.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckGameServerGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGameServerGroupSettingsConfig(rName, gamelift.BalancingStrategyOnDemandOnly, gamelift.GameServerProtectionPolicyFullProtection, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGameServerGroupExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "balance_strategy", gamelift.BalancingStrategyOnDemandOnly),
+					resource.TestCheckResourceAttr(resourceName, "game_server_protection_policy", gamelift.GameServerProtectionPolicyFullProtection),
+					resource.TestCheckResourceAttr(resourceName, "instance_definitions.0.weighted_capacity", "1"),
+				),
+			},
+			{
+				Config: testAccGameServerGroupSettingsConfig(rName, gamelift.BalancingStrategySpotPreferred, gamelift.GameServerProtectionPolicyNoProtection, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGameServerGroupExists(resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "balance_strategy", gamelift.BalancingStrategySpotPreferred),
+					resource.TestCheckResourceAttr(resourceName, "game_server_protection_policy", gamelift.GameServerProtectionPolicyNoProtection),
+					resource.TestCheckResourceAttr(resourceName, "instance_definitions.0.weighted_capacity", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGameServerGroupExists(n string, v *gamelift.GameServerGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No GameLift Game Server Group ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+
+		output, err := tfgamelift.FindGameServerGroupByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckGameServerGroupDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).GameLiftConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_gamelift_game_server_group" {
+			continue
+		}
+
+		_, err := tfgamelift.FindGameServerGroupByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("GameLift Game Server Group %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccGameServerGroupBaseConfig(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHvmEbsAmi(),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = [
+          "autoscaling.amazonaws.com",
+          "gamelift.amazonaws.com",
+        ]
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "test" {
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/GameLiftGameServerGroupPolicy"
+  role       = aws_iam_role.test.name
+}
+
+resource "aws_launch_template" "test" {
+  image_id = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+  name     = %[1]q
+}
+`, rName))
+}
+
+func testAccGameServerGroupConfig(rName string, minSize, maxSize int) string {
+	return acctest.ConfigCompose(testAccGameServerGroupBaseConfig(rName), fmt.Sprintf(`
+resource "aws_gamelift_game_server_group" "test" {
+  game_server_group_name = %[1]q
+
+  instance_definitions {
+    instance_type = "c5.large"
+  }
+
+  instance_definitions {
+    instance_type = "c5a.large"
+  }
+
+  launch_template {
+    id = aws_launch_template.test.id
+  }
+
+  max_size = %[3]d
+  min_size = %[2]d
+  role_arn = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, minSize, maxSize))
+}
+
+func testAccGameServerGroupSettingsConfig(rName, balanceStrategy, protectionPolicy, weightedCapacity string) string {
+	return acctest.ConfigCompose(testAccGameServerGroupBaseConfig(rName), fmt.Sprintf(`
+resource "aws_gamelift_game_server_group" "test" {
+  game_server_group_name        = %[1]q
+  balance_strategy              = %[2]q
+  game_server_protection_policy = %[3]q
+
+  instance_definitions {
+    instance_type     = "c5.large"
+    weighted_capacity = %[4]q
+  }
+
+  instance_definitions {
+    instance_type     = "c5a.large"
+    weighted_capacity = %[4]q
+  }
+
+  launch_template {
+    id = aws_launch_template.test.id
+  }
+
+  max_size = 1
+  min_size = 1
+  role_arn = aws_iam_role.test.arn
+
+  depends_on = [aws_iam_role_policy_attachment.test]
+}
+`, rName, balanceStrategy, protectionPolicy, weightedCapacity))
+}
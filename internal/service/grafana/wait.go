@@ -0,0 +1,30 @@
+package grafana
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	workspaceUpdatedTimeout = 20 * time.Minute
+)
+
+func waitWorkspaceUpdated(ctx context.Context, conn *managedgrafana.ManagedGrafana, id string) (*managedgrafana.WorkspaceDescription, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{managedgrafana.WorkspaceStatusUpdating},
+		Target:  []string{managedgrafana.WorkspaceStatusActive},
+		Refresh: statusWorkspace(ctx, conn, id),
+		Timeout: workspaceUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*managedgrafana.WorkspaceDescription); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,252 @@
+package grafana
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func ResourceWorkspaceSAMLConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceWorkspaceSAMLConfigurationUpsert,
+		ReadContext:   resourceWorkspaceSAMLConfigurationRead,
+		UpdateContext: resourceWorkspaceSAMLConfigurationUpsert,
+		DeleteContext: resourceWorkspaceSAMLConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"admin_role_values": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_organizations": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"editor_role_values": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"email_attribute_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"groups_attribute_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"idp_metadata_url": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+				ExactlyOneOf: []string{"idp_metadata_url", "idp_metadata_xml"},
+			},
+			"idp_metadata_xml": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"idp_metadata_url", "idp_metadata_xml"},
+			},
+			"login_attribute_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"login_validity_duration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"name_attribute_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+			},
+			"org_attribute_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"role_attribute_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"workspace_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceWorkspaceSAMLConfigurationUpsert(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	workspaceID := d.Get("workspace_id").(string)
+
+	output, err := conn.DescribeWorkspaceAuthenticationWithContext(ctx, &managedgrafana.DescribeWorkspaceAuthenticationInput{
+		WorkspaceId: aws.String(workspaceID),
+	})
+
+	if err != nil {
+		return diag.Errorf("error reading Grafana Workspace (%s) authentication: %s", workspaceID, err)
+	}
+
+	// Keep any other authentication providers, such as AWS SSO, enabled on the workspace.
+	authenticationProviders := []*string{aws.String(managedgrafana.AuthenticationProviderTypesSaml)}
+
+	if output.Authentication != nil {
+		for _, v := range output.Authentication.Providers {
+			if aws.StringValue(v) != managedgrafana.AuthenticationProviderTypesSaml {
+				authenticationProviders = append(authenticationProviders, v)
+			}
+		}
+	}
+
+	samlConfiguration := &managedgrafana.SamlConfiguration{
+		AssertionAttributes: &managedgrafana.AssertionAttributes{},
+		IdpMetadata:         &managedgrafana.IdpMetadata{},
+		RoleValues: &managedgrafana.RoleValues{
+			Editor: flex.ExpandStringList(d.Get("editor_role_values").([]interface{})),
+		},
+	}
+
+	if v, ok := d.GetOk("admin_role_values"); ok && len(v.([]interface{})) > 0 {
+		samlConfiguration.RoleValues.Admin = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("allowed_organizations"); ok && len(v.([]interface{})) > 0 {
+		samlConfiguration.AllowedOrganizations = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	if v, ok := d.GetOk("email_attribute_name"); ok {
+		samlConfiguration.AssertionAttributes.Email = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("groups_attribute_name"); ok {
+		samlConfiguration.AssertionAttributes.Groups = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("idp_metadata_url"); ok {
+		samlConfiguration.IdpMetadata.Url = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("idp_metadata_xml"); ok {
+		samlConfiguration.IdpMetadata.Xml = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("login_attribute_name"); ok {
+		samlConfiguration.AssertionAttributes.Login = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("login_validity_duration"); ok {
+		samlConfiguration.LoginValidityDuration = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("name_attribute_name"); ok {
+		samlConfiguration.AssertionAttributes.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("org_attribute_name"); ok {
+		samlConfiguration.AssertionAttributes.Org = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_attribute_name"); ok {
+		samlConfiguration.AssertionAttributes.Role = aws.String(v.(string))
+	}
+
+	input := &managedgrafana.UpdateWorkspaceAuthenticationInput{
+		AuthenticationProviders: authenticationProviders,
+		SamlConfiguration:       samlConfiguration,
+		WorkspaceId:             aws.String(workspaceID),
+	}
+
+	log.Printf("[DEBUG] Updating Grafana Workspace SAML Configuration: %s", input)
+	_, err = conn.UpdateWorkspaceAuthenticationWithContext(ctx, input)
+
+	if err != nil {
+		return diag.Errorf("error updating Grafana Workspace (%s) SAML configuration: %s", workspaceID, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(workspaceID)
+	}
+
+	if _, err := waitWorkspaceUpdated(ctx, conn, d.Id()); err != nil {
+		return diag.Errorf("error waiting for Grafana Workspace (%s) update: %s", d.Id(), err)
+	}
+
+	return resourceWorkspaceSAMLConfigurationRead(ctx, d, meta)
+}
+
+func resourceWorkspaceSAMLConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	conn := meta.(*conns.AWSClient).GrafanaConn
+
+	saml, err := FindSAMLConfigurationByWorkspaceID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Grafana Workspace SAML Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return diag.Errorf("error reading Grafana Workspace SAML Configuration (%s): %s", d.Id(), err)
+	}
+
+	if v := saml.Configuration; v != nil {
+		d.Set("allowed_organizations", aws.StringValueSlice(v.AllowedOrganizations))
+		d.Set("login_validity_duration", v.LoginValidityDuration)
+
+		if v := v.AssertionAttributes; v != nil {
+			d.Set("email_attribute_name", v.Email)
+			d.Set("groups_attribute_name", v.Groups)
+			d.Set("login_attribute_name", v.Login)
+			d.Set("name_attribute_name", v.Name)
+			d.Set("org_attribute_name", v.Org)
+			d.Set("role_attribute_name", v.Role)
+		}
+
+		if v := v.IdpMetadata; v != nil {
+			d.Set("idp_metadata_url", v.Url)
+			d.Set("idp_metadata_xml", v.Xml)
+		}
+
+		if v := v.RoleValues; v != nil {
+			d.Set("admin_role_values", aws.StringValueSlice(v.Admin))
+			d.Set("editor_role_values", aws.StringValueSlice(v.Editor))
+		}
+	}
+
+	d.Set("status", saml.Status)
+	d.Set("workspace_id", d.Id())
+
+	return nil
+}
+
+func resourceWorkspaceSAMLConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The SAML configuration cannot be removed from a workspace that uses SAML authentication,
+	// it is only discarded along with the workspace or its SAML authentication provider.
+	log.Printf("[WARN] Grafana Workspace SAML Configuration (%s) cannot be deleted, removing from state", d.Id())
+
+	return nil
+}
@@ -0,0 +1,130 @@
+package grafana_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/managedgrafana"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfgrafana "github.com/hashicorp/terraform-provider-aws/internal/service/grafana"
+)
+
+// The workspace must already exist and have SAML enabled as an authentication provider.
+func testAccWorkspaceIDFromEnv(t *testing.T) string {
+	key := "GRAFANA_SAML_WORKSPACE_ID"
+	workspaceID := os.Getenv(key)
+
+	if workspaceID == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	return workspaceID
+}
+
+func TestAccGrafanaWorkspaceSAMLConfiguration_basic(t *testing.T) {
+	var v managedgrafana.SamlAuthentication
+	workspaceID := testAccWorkspaceIDFromEnv(t)
+	resourceName := "aws_grafana_workspace_saml_configuration.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(managedgrafana.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, managedgrafana.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkspaceSAMLConfigurationConfig(workspaceID, "editor"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkspaceSAMLConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "admin_role_values.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "editor_role_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "editor_role_values.0", "editor"),
+					resource.TestCheckResourceAttr(resourceName, "idp_metadata_url", "https://portal.sso.us-east-2.amazonaws.com/saml/metadata/NjMwMDg2NDc4OTA3X2lucy1jY2E2YTFmMTY3ODk0NTUy"),
+					resource.TestCheckResourceAttr(resourceName, "status", managedgrafana.SamlConfigurationStatusConfigured),
+					resource.TestCheckResourceAttr(resourceName, "workspace_id", workspaceID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccWorkspaceSAMLConfigurationAllAttributesConfig(workspaceID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckWorkspaceSAMLConfigurationExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "admin_role_values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "admin_role_values.0", "admin"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_organizations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_organizations.0", "org"),
+					resource.TestCheckResourceAttr(resourceName, "email_attribute_name", "email"),
+					resource.TestCheckResourceAttr(resourceName, "groups_attribute_name", "groups"),
+					resource.TestCheckResourceAttr(resourceName, "login_attribute_name", "login"),
+					resource.TestCheckResourceAttr(resourceName, "login_validity_duration", "60"),
+					resource.TestCheckResourceAttr(resourceName, "name_attribute_name", "name"),
+					resource.TestCheckResourceAttr(resourceName, "org_attribute_name", "org"),
+					resource.TestCheckResourceAttr(resourceName, "role_attribute_name", "role"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckWorkspaceSAMLConfigurationExists(n string, v *managedgrafana.SamlAuthentication) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Grafana Workspace SAML Configuration ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).GrafanaConn
+
+		output, err := tfgrafana.FindSAMLConfigurationByWorkspaceID(context.Background(), conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccWorkspaceSAMLConfigurationConfig(workspaceID, editorRoleValue string) string {
+	return fmt.Sprintf(`
+resource "aws_grafana_workspace_saml_configuration" "test" {
+  editor_role_values = [%[2]q]
+  idp_metadata_url   = "https://portal.sso.us-east-2.amazonaws.com/saml/metadata/NjMwMDg2NDc4OTA3X2lucy1jY2E2YTFmMTY3ODk0NTUy"
+  workspace_id       = %[1]q
+}
+`, workspaceID, editorRoleValue)
+}
+
+func testAccWorkspaceSAMLConfigurationAllAttributesConfig(workspaceID string) string {
+	return fmt.Sprintf(`
+resource "aws_grafana_workspace_saml_configuration" "test" {
+  admin_role_values       = ["admin"]
+  allowed_organizations   = ["org"]
+  editor_role_values      = ["editor"]
+  email_attribute_name    = "email"
+  groups_attribute_name   = "groups"
+  idp_metadata_url        = "https://portal.sso.us-east-2.amazonaws.com/saml/metadata/NjMwMDg2NDc4OTA3X2lucy1jY2E2YTFmMTY3ODk0NTUy"
+  login_attribute_name    = "login"
+  login_validity_duration = 60
+  name_attribute_name     = "name"
+  org_attribute_name      = "org"
+  role_attribute_name     = "role"
+  workspace_id            = %[1]q
+}
+`, workspaceID)
+}
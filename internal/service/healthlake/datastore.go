@@ -0,0 +1,357 @@
+package healthlake
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDatastore() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDatastoreCreate,
+		Read:   resourceDatastoreRead,
+		Update: resourceDatastoreUpdate,
+		Delete: resourceDatastoreDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"created_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(1, 256),
+			},
+			"datastore_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"datastore_type_version": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(healthlake.FHIRVersion_Values(), false),
+			},
+			"preload_data_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"preload_data_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.StringInSlice(healthlake.PreloadDataType_Values(), false),
+						},
+					},
+				},
+			},
+			"sse_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"kms_encryption_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cmk_type": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validation.StringInSlice(healthlake.CmkType_Values(), false),
+									},
+									"kms_key_id": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceDatastoreCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).HealthLakeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &healthlake.CreateFHIRDatastoreInput{
+		ClientToken:          aws.String(resource.UniqueId()),
+		DatastoreTypeVersion: aws.String(d.Get("datastore_type_version").(string)),
+	}
+
+	if v, ok := d.GetOk("datastore_name"); ok {
+		input.DatastoreName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("preload_data_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PreloadDataConfig = expandPreloadDataConfig(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("sse_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.SseConfiguration = expandSseConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating HealthLake Datastore: %s", input)
+	output, err := conn.CreateFHIRDatastore(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating HealthLake Datastore: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.DatastoreId))
+
+	if _, err := waitDatastoreActive(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for HealthLake Datastore (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceDatastoreRead(d, meta)
+}
+
+func resourceDatastoreRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).HealthLakeConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	datastore, err := FindDatastoreByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] HealthLake Datastore (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading HealthLake Datastore (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(datastore.DatastoreArn)
+	if v := datastore.CreatedAt; v != nil {
+		d.Set("created_at", aws.TimeValue(v).Format(time.RFC3339))
+	} else {
+		d.Set("created_at", nil)
+	}
+	d.Set("datastore_arn", arn)
+	d.Set("datastore_endpoint", datastore.DatastoreEndpoint)
+	d.Set("datastore_id", datastore.DatastoreId)
+	d.Set("datastore_name", datastore.DatastoreName)
+	d.Set("datastore_status", datastore.DatastoreStatus)
+	d.Set("datastore_type_version", datastore.DatastoreTypeVersion)
+
+	if datastore.PreloadDataConfig != nil {
+		if err := d.Set("preload_data_config", []interface{}{flattenPreloadDataConfig(datastore.PreloadDataConfig)}); err != nil {
+			return fmt.Errorf("error setting preload_data_config: %w", err)
+		}
+	} else {
+		d.Set("preload_data_config", nil)
+	}
+
+	if datastore.SseConfiguration != nil {
+		if err := d.Set("sse_configuration", []interface{}{flattenSseConfiguration(datastore.SseConfiguration)}); err != nil {
+			return fmt.Errorf("error setting sse_configuration: %w", err)
+		}
+	} else {
+		d.Set("sse_configuration", nil)
+	}
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for HealthLake Datastore (%s): %w", arn, err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDatastoreUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).HealthLakeConn
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("datastore_arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating HealthLake Datastore (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceDatastoreRead(d, meta)
+}
+
+func resourceDatastoreDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).HealthLakeConn
+
+	log.Printf("[DEBUG] Deleting HealthLake Datastore: %s", d.Id())
+	_, err := conn.DeleteFHIRDatastore(&healthlake.DeleteFHIRDatastoreInput{
+		DatastoreId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, healthlake.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting HealthLake Datastore (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitDatastoreDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for HealthLake Datastore (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandPreloadDataConfig(tfMap map[string]interface{}) *healthlake.PreloadDataConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &healthlake.PreloadDataConfig{}
+
+	if v, ok := tfMap["preload_data_type"].(string); ok && v != "" {
+		apiObject.PreloadDataType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandSseConfiguration(tfMap map[string]interface{}) *healthlake.SseConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &healthlake.SseConfiguration{}
+
+	if v, ok := tfMap["kms_encryption_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		apiObject.KmsEncryptionConfig = expandKmsEncryptionConfig(v[0].(map[string]interface{}))
+	}
+
+	return apiObject
+}
+
+func expandKmsEncryptionConfig(tfMap map[string]interface{}) *healthlake.KmsEncryptionConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &healthlake.KmsEncryptionConfig{}
+
+	if v, ok := tfMap["cmk_type"].(string); ok && v != "" {
+		apiObject.CmkType = aws.String(v)
+	}
+
+	if v, ok := tfMap["kms_key_id"].(string); ok && v != "" {
+		apiObject.KmsKeyId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenPreloadDataConfig(apiObject *healthlake.PreloadDataConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.PreloadDataType; v != nil {
+		tfMap["preload_data_type"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
+
+func flattenSseConfiguration(apiObject *healthlake.SseConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.KmsEncryptionConfig; v != nil {
+		tfMap["kms_encryption_config"] = []interface{}{flattenKmsEncryptionConfig(v)}
+	}
+
+	return tfMap
+}
+
+func flattenKmsEncryptionConfig(apiObject *healthlake.KmsEncryptionConfig) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.CmkType; v != nil {
+		tfMap["cmk_type"] = aws.StringValue(v)
+	}
+
+	if v := apiObject.KmsKeyId; v != nil {
+		tfMap["kms_key_id"] = aws.StringValue(v)
+	}
+
+	return tfMap
+}
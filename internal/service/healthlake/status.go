@@ -0,0 +1,24 @@
+package healthlake
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func statusDatastore(conn *healthlake.HealthLake, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindDatastoreByID(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.DatastoreStatus), nil
+	}
+}
@@ -0,0 +1,47 @@
+package healthlake
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/healthlake"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	datastoreActiveTimeout  = 30 * time.Minute
+	datastoreDeletedTimeout = 30 * time.Minute
+)
+
+func waitDatastoreActive(conn *healthlake.HealthLake, id string) (*healthlake.DatastoreProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{healthlake.DatastoreStatusCreating},
+		Target:  []string{healthlake.DatastoreStatusActive},
+		Refresh: statusDatastore(conn, id),
+		Timeout: datastoreActiveTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*healthlake.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDatastoreDeleted(conn *healthlake.HealthLake, id string) (*healthlake.DatastoreProperties, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{healthlake.DatastoreStatusActive, healthlake.DatastoreStatusDeleting},
+		Target:  []string{},
+		Refresh: statusDatastore(conn, id),
+		Timeout: datastoreDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*healthlake.DatastoreProperties); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -347,6 +347,10 @@ func resourceRoleUpdate(d *schema.ResourceData, meta interface{}) error {
 		if err != nil {
 			return fmt.Errorf("error updating IAM Role (%s) assume role policy: %w", d.Id(), err)
 		}
+
+		if _, err := waitRoleAssumeRolePolicyUpdated(conn, d.Id(), d.Get("assume_role_policy").(string)); err != nil {
+			return fmt.Errorf("error waiting for IAM Role (%s) assume role policy update: %w", d.Id(), err)
+		}
 	}
 
 	if d.HasChange("description") {
@@ -176,6 +176,36 @@ func TestAccIAMRole_testNameChange(t *testing.T) {
 	})
 }
 
+func TestAccIAMRole_assumeRolePolicyUpdate(t *testing.T) {
+	var role1, role2 iam.Role
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, iam.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRoleConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(resourceName, &role1),
+					resource.TestMatchResourceAttr(resourceName, "assume_role_policy", regexp.MustCompile(`ec2\.`)),
+				),
+			},
+			{
+				Config: testAccRoleAssumeRolePolicyUpdatedConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRoleExists(resourceName, &role2),
+					testAccCheckRoleNotRecreated(&role1, &role2),
+					resource.TestMatchResourceAttr(resourceName, "assume_role_policy", regexp.MustCompile(`lambda\.`)),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIAMRole_badJSON(t *testing.T) {
 	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
 
@@ -876,6 +906,16 @@ func testAccCheckRoleExists(n string, v *iam.Role) resource.TestCheckFunc {
 	}
 }
 
+func testAccCheckRoleNotRecreated(i, j *iam.Role) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if aws.StringValue(i.RoleId) != aws.StringValue(j.RoleId) {
+			return fmt.Errorf("IAM Role (%s) recreated", aws.StringValue(i.RoleName))
+		}
+
+		return nil
+	}
+}
+
 // Attach inline policy out of band (outside of terraform)
 func testAccAddRolePolicy(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
@@ -1125,6 +1165,36 @@ EOF
 `, rName)
 }
 
+func testAccRoleAssumeRolePolicyUpdatedConfig(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = "test-role-%s"
+  path = "/"
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": [
+          "lambda.${data.aws_partition.current.dns_suffix}"
+        ]
+      },
+      "Action": [
+        "sts:AssumeRole"
+      ]
+    }
+  ]
+}
+EOF
+}
+`, rName)
+}
+
 func testAccRoleWithDescriptionConfig(rName string) string {
 	return fmt.Sprintf(`
 data "aws_partition" "current" {}
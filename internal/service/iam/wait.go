@@ -1,12 +1,14 @@
 package iam
 
 import (
+	"net/url"
 	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	awspolicy "github.com/hashicorp/awspolicyequivalence"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -22,6 +24,9 @@ const (
 	RoleStatusARNIsUniqueID = "uniqueid"
 	RoleStatusARNIsARN      = "arn"
 	RoleStatusNotFound      = "notfound"
+
+	RoleAssumeRolePolicyStatusPending = "pending"
+	RoleAssumeRolePolicyStatusUpdated = "updated"
 )
 
 func waitRoleARNIsNotUniqueID(conn *iam.IAM, id string, role *iam.Role) (*iam.Role, error) {
@@ -67,6 +72,58 @@ func statusRoleCreate(conn *iam.IAM, id string, role *iam.Role) resource.StateRe
 	}
 }
 
+// waitRoleAssumeRolePolicyUpdated waits until GetRole returns a trust policy
+// equivalent to the one just set via UpdateAssumeRolePolicy.
+func waitRoleAssumeRolePolicyUpdated(conn *iam.IAM, id, policy string) (*iam.Role, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:                   []string{RoleAssumeRolePolicyStatusPending},
+		Target:                    []string{RoleAssumeRolePolicyStatusUpdated},
+		Refresh:                   statusRoleAssumeRolePolicy(conn, id, policy),
+		Timeout:                   PropagationTimeout,
+		ContinuousTargetOccurence: 3,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*iam.Role); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func statusRoleAssumeRolePolicy(conn *iam.IAM, id, policy string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := FindRoleByName(conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		assumeRolePolicy, err := url.QueryUnescape(aws.StringValue(output.AssumeRolePolicyDocument))
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		equivalent, err := awspolicy.PoliciesAreEquivalent(assumeRolePolicy, policy)
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if !equivalent {
+			return output, RoleAssumeRolePolicyStatusPending, nil
+		}
+
+		return output, RoleAssumeRolePolicyStatusUpdated, nil
+	}
+}
+
 func waitDeleteServiceLinkedRole(conn *iam.IAM, deletionTaskID string) error {
 	stateConf := &resource.StateChangeConf{
 		Pending: []string{iam.DeletionTaskStatusTypeInProgress, iam.DeletionTaskStatusTypeNotStarted},
@@ -0,0 +1,73 @@
+package iot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/iot"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIoTThingGroupDataSource_basic(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_iot_thing_group.test"
+	dataSourceName := "data.aws_iot_thing_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, iot.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccThingGroupDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metadata.#", resourceName, "metadata.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "metadata.0.parent_group_name", resourceName, "metadata.0.parent_group_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "parent_group_name", resourceName, "parent_group_name"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "properties.#", resourceName, "properties.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "properties.0.attribute_payload.0.attributes.%", resourceName, "properties.0.attribute_payload.0.attributes.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "properties.0.description", resourceName, "properties.0.description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "tags.%", resourceName, "tags.%"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "version", resourceName, "version"),
+				),
+			},
+		},
+	})
+}
+
+func testAccThingGroupDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iot_thing_group" "parent" {
+  name = "%[1]s-parent"
+}
+
+resource "aws_iot_thing_group" "test" {
+  name = %[1]q
+
+  parent_group_name = aws_iot_thing_group.parent.name
+
+  properties {
+    attribute_payload {
+      attributes = {
+        One = "11111"
+        Two = "TwoTwo"
+      }
+    }
+
+    description = "test description"
+  }
+
+  tags = {
+    Key1 = "Value1"
+  }
+}
+
+data "aws_iot_thing_group" "test" {
+  name = aws_iot_thing_group.test.name
+}
+`, rName)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iot"
@@ -43,6 +44,10 @@ func ResourceThingGroupMembership() *schema.Resource {
 	}
 }
 
+const (
+	thingGroupMembershipPropagationTimeout = 1 * time.Minute
+)
+
 func resourceThingGroupMembershipCreate(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).IoTConn
 
@@ -58,7 +63,10 @@ func resourceThingGroupMembershipCreate(d *schema.ResourceData, meta interface{}
 	}
 
 	log.Printf("[DEBUG] Creating IoT Thing Group Membership: %s", input)
-	_, err := conn.AddThingToThingGroup(input)
+	// A newly created thing group or thing may not yet be visible.
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(thingGroupMembershipPropagationTimeout, func() (interface{}, error) {
+		return conn.AddThingToThingGroup(input)
+	}, iot.ErrCodeResourceNotFoundException)
 
 	if err != nil {
 		return fmt.Errorf("error adding IoT Thing (%s) to IoT Thing Group (%s): %w", thingName, thingGroupName, err)
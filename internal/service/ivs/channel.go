@@ -0,0 +1,227 @@
+package ivs
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceChannelCreate,
+		Read:   resourceChannelRead,
+		Update: resourceChannelUpdate,
+		Delete: resourceChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorized": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"ingest_endpoint": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"latency_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ivs.ChannelLatencyMode_Values(), false),
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9-_]{0,128}$`), "must contain only alphanumeric characters, hyphens and underscores, and be at most 128 characters"),
+			},
+			"playback_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recording_configuration_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(ivs.ChannelType_Values(), false),
+			},
+		},
+	}
+}
+
+func resourceChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IVSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	input := &ivs.CreateChannelInput{}
+
+	if v, ok := d.GetOk("authorized"); ok {
+		input.Authorized = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("latency_mode"); ok {
+		input.LatencyMode = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("name"); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("recording_configuration_arn"); ok {
+		input.RecordingConfigurationArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("type"); ok {
+		input.Type = aws.String(v.(string))
+	}
+
+	log.Printf("[DEBUG] Creating IVS Channel: %s", input)
+	output, err := conn.CreateChannel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating IVS Channel: %w", err)
+	}
+
+	d.SetId(aws.StringValue(output.Channel.Arn))
+
+	return resourceChannelRead(d, meta)
+}
+
+func resourceChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IVSConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	channel, err := FindChannelByARN(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] IVS Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading IVS Channel (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", channel.Arn)
+	d.Set("authorized", channel.Authorized)
+	d.Set("ingest_endpoint", channel.IngestEndpoint)
+	d.Set("latency_mode", channel.LatencyMode)
+	d.Set("name", channel.Name)
+	d.Set("playback_url", channel.PlaybackUrl)
+	d.Set("recording_configuration_arn", channel.RecordingConfigurationArn)
+	d.Set("type", channel.Type)
+
+	tags := KeyValueTags(channel.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IVSConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &ivs.UpdateChannelInput{
+			Arn: aws.String(d.Id()),
+		}
+
+		if d.HasChange("authorized") {
+			input.Authorized = aws.Bool(d.Get("authorized").(bool))
+		}
+
+		if d.HasChange("latency_mode") {
+			input.LatencyMode = aws.String(d.Get("latency_mode").(string))
+		}
+
+		if d.HasChange("name") {
+			input.Name = aws.String(d.Get("name").(string))
+		}
+
+		if d.HasChange("recording_configuration_arn") {
+			// An empty ARN disables recording.
+			input.RecordingConfigurationArn = aws.String(d.Get("recording_configuration_arn").(string))
+		}
+
+		if d.HasChange("type") {
+			input.Type = aws.String(d.Get("type").(string))
+		}
+
+		log.Printf("[DEBUG] Updating IVS Channel: %s", input)
+		_, err := conn.UpdateChannel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating IVS Channel (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Id(), o, n); err != nil {
+			return fmt.Errorf("error updating IVS Channel (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceChannelRead(d, meta)
+}
+
+func resourceChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IVSConn
+
+	log.Printf("[DEBUG] Deleting IVS Channel: %s", d.Id())
+	_, err := conn.DeleteChannel(&ivs.DeleteChannelInput{
+		Arn: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, ivs.ErrCodeResourceNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting IVS Channel (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,257 @@
+package ivs_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivs"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfivs "github.com/hashicorp/terraform-provider-aws/internal/service/ivs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccIVSChannel_basic(t *testing.T) {
+	var v ivs.Channel
+	resourceName := "aws_ivs_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(ivs.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ivs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "ivs", regexp.MustCompile(`channel/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "authorized", "false"),
+					resource.TestCheckResourceAttrSet(resourceName, "ingest_endpoint"),
+					resource.TestCheckResourceAttr(resourceName, "latency_mode", ivs.ChannelLatencyModeLow),
+					resource.TestCheckResourceAttrSet(resourceName, "playback_url"),
+					resource.TestCheckResourceAttr(resourceName, "recording_configuration_arn", ""),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "type", ivs.ChannelTypeStandard),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSChannel_disappears(t *testing.T) {
+	var v ivs.Channel
+	resourceName := "aws_ivs_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(ivs.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ivs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfivs.ResourceChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSChannel_update(t *testing.T) {
+	var v1, v2 ivs.Channel
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ivs_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(ivs.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ivs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfigName(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v1),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+				),
+			},
+			{
+				Config: testAccChannelConfigAllArguments(rName, true, ivs.ChannelLatencyModeNormal, ivs.ChannelTypeBasic),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v2),
+					testAccCheckChannelNotRecreated(&v1, &v2),
+					resource.TestCheckResourceAttr(resourceName, "authorized", "true"),
+					resource.TestCheckResourceAttr(resourceName, "latency_mode", ivs.ChannelLatencyModeNormal),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttr(resourceName, "type", ivs.ChannelTypeBasic),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccIVSChannel_tags(t *testing.T) {
+	var v ivs.Channel
+	resourceName := "aws_ivs_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(ivs.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ivs.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfigTags1("key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelConfigTags2("key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccChannelConfigTags1("key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckChannelExists(n string, v *ivs.Channel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No IVS Channel ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).IVSConn
+
+		output, err := tfivs.FindChannelByARN(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckChannelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).IVSConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_ivs_channel" {
+			continue
+		}
+
+		_, err := tfivs.FindChannelByARN(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("IVS Channel %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckChannelNotRecreated(before, after *ivs.Channel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.Arn), aws.StringValue(after.Arn); before != after {
+			return fmt.Errorf("IVS Channel (%s/%s) recreated", before, after)
+		}
+
+		return nil
+	}
+}
+
+const testAccChannelConfig = `
+resource "aws_ivs_channel" "test" {}
+`
+
+func testAccChannelConfigName(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_channel" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccChannelConfigAllArguments(rName string, authorized bool, latencyMode, channelType string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_channel" "test" {
+  authorized   = %[2]t
+  latency_mode = %[3]q
+  name         = %[1]q
+  type         = %[4]q
+}
+`, rName, authorized, latencyMode, channelType)
+}
+
+func testAccChannelConfigTags1(tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_channel" "test" {
+  tags = {
+    %[1]q = %[2]q
+  }
+}
+`, tagKey1, tagValue1)
+}
+
+func testAccChannelConfigTags2(tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_ivs_channel" "test" {
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+}
+`, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,102 @@
+package ivs
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ivs"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func FindChannelByARN(conn *ivs.IVS, arn string) (*ivs.Channel, error) {
+	input := &ivs.GetChannelInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetChannel(input)
+
+	if tfawserr.ErrCodeEquals(err, ivs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Channel == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Channel, nil
+}
+
+func FindStreamKeyByChannelARN(conn *ivs.IVS, channelARN string) (*ivs.StreamKey, error) {
+	input := &ivs.ListStreamKeysInput{
+		ChannelArn: aws.String(channelARN),
+	}
+
+	var summaries []*ivs.StreamKeySummary
+
+	err := conn.ListStreamKeysPages(input, func(page *ivs.ListStreamKeysOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.StreamKeys {
+			if v != nil {
+				summaries = append(summaries, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, ivs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(summaries) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if count := len(summaries); count > 1 {
+		return nil, tfresource.NewTooManyResultsError(count, input)
+	}
+
+	return FindStreamKeyByARN(conn, aws.StringValue(summaries[0].Arn))
+}
+
+func FindStreamKeyByARN(conn *ivs.IVS, arn string) (*ivs.StreamKey, error) {
+	input := &ivs.GetStreamKeyInput{
+		Arn: aws.String(arn),
+	}
+
+	output, err := conn.GetStreamKey(input)
+
+	if tfawserr.ErrCodeEquals(err, ivs.ErrCodeResourceNotFoundException) {
+		return nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.StreamKey == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.StreamKey, nil
+}
@@ -0,0 +1,60 @@
+package ivs
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceStreamKey() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceStreamKeyRead,
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags": tftags.TagsSchemaComputed(),
+			"value": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceStreamKeyRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).IVSConn
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	channelARN := d.Get("channel_arn").(string)
+
+	streamKey, err := FindStreamKeyByChannelARN(conn, channelARN)
+
+	if err != nil {
+		return tfresource.SingularDataSourceFindError("IVS Stream Key", err)
+	}
+
+	d.SetId(aws.StringValue(streamKey.Arn))
+	d.Set("arn", streamKey.Arn)
+	d.Set("channel_arn", streamKey.ChannelArn)
+	d.Set("value", streamKey.Value)
+
+	if err := d.Set("tags", KeyValueTags(streamKey.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package ivs_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ivs"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccIVSStreamKeyDataSource_basic(t *testing.T) {
+	dataSourceName := "data.aws_ivs_stream_key.test"
+	channelResourceName := "aws_ivs_channel.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(ivs.EndpointsID, t) },
+		ErrorCheck: acctest.ErrorCheck(t, ivs.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamKeyDataSourceConfig,
+				Check: resource.ComposeTestCheckFunc(
+					acctest.MatchResourceAttrRegionalARN(dataSourceName, "arn", "ivs", regexp.MustCompile(`stream-key/.+`)),
+					resource.TestCheckResourceAttrPair(dataSourceName, "channel_arn", channelResourceName, "arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "value"),
+				),
+			},
+		},
+	})
+}
+
+const testAccStreamKeyDataSourceConfig = `
+resource "aws_ivs_channel" "test" {}
+
+data "aws_ivs_stream_key" "test" {
+  channel_arn = aws_ivs_channel.test.arn
+}
+`
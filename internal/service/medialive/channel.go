@@ -0,0 +1,1040 @@
+package medialive
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceChannel() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceChannelCreate,
+		Read:   resourceChannelRead,
+		Update: resourceChannelUpdate,
+		Delete: resourceChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			resourceChannelCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_class": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      medialive.ChannelClassStandard,
+				ValidateFunc: validation.StringInSlice(medialive.ChannelClass_Values(), false),
+			},
+			"channel_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"destinations": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"media_package_settings": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"channel_id": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+								},
+							},
+						},
+						"settings": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"password_param": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"stream_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"url": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"username": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"encoder_settings": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"audio_descriptions": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"audio_selector_name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"language_code": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"stream_name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+								},
+							},
+						},
+						"output_groups": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Optional: true,
+									},
+									"output_group_settings": outputGroupSettingsSchema(),
+									"outputs": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"audio_description_names": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"caption_description_names": {
+													Type:     schema.TypeSet,
+													Optional: true,
+													Elem:     &schema.Schema{Type: schema.TypeString},
+												},
+												"output_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+													Computed: true,
+												},
+												"output_settings": outputSettingsSchema(),
+												"video_description_name": {
+													Type:     schema.TypeString,
+													Optional: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"timecode_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"source": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice(medialive.TimecodeConfigSource_Values(), false),
+									},
+									"sync_threshold": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(1, 1000000),
+									},
+								},
+							},
+						},
+						"video_descriptions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"height": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+									"name": {
+										Type:     schema.TypeString,
+										Required: true,
+									},
+									"respond_to_afd": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(medialive.VideoDescriptionRespondToAfd_Values(), false),
+									},
+									"scaling_behavior": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.StringInSlice(medialive.VideoDescriptionScalingBehavior_Values(), false),
+									},
+									"sharpness": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IntBetween(0, 100),
+									},
+									"width": {
+										Type:     schema.TypeInt,
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"input_attachments": {
+				Type:     schema.TypeList,
+				Required: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"input_attachment_name": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"input_id": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"input_specification": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"codec": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(medialive.InputCodec_Values(), false),
+						},
+						"input_resolution": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(medialive.InputResolution_Values(), false),
+						},
+						"maximum_bitrate": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(medialive.InputMaximumBitrate_Values(), false),
+						},
+					},
+				},
+			},
+			"log_level": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice(medialive.LogLevel_Values(), false),
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+			"vpc": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"availability_zones": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"network_interface_ids": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"public_address_allocation_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"security_group_ids": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 5,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"subnet_ids": {
+							Type:     schema.TypeSet,
+							Required: true,
+							ForceNew: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceChannelCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if v, ok := diff.Get("encoder_settings").([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+
+		if v, ok := tfMap["output_groups"].([]interface{}); ok {
+			if err := validateOutputGroups(v); err != nil {
+				return fmt.Errorf("encoder_settings.0.%w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func destinationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination_ref_id": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+			},
+		},
+	}
+}
+
+func resourceChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("name").(string)
+	input := &medialive.CreateChannelInput{
+		ChannelClass:       aws.String(d.Get("channel_class").(string)),
+		Destinations:       expandOutputDestinations(d.Get("destinations").(*schema.Set).List()),
+		EncoderSettings:    expandEncoderSettings(d.Get("encoder_settings").([]interface{})),
+		InputAttachments:   expandInputAttachments(d.Get("input_attachments").([]interface{})),
+		InputSpecification: expandInputSpecification(d.Get("input_specification").([]interface{})),
+		Name:               aws.String(name),
+	}
+
+	if v, ok := d.GetOk("log_level"); ok {
+		input.LogLevel = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("role_arn"); ok {
+		input.RoleArn = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	if v, ok := d.GetOk("vpc"); ok {
+		input.Vpc = expandVPCOutputSettings(v.([]interface{}))
+	}
+
+	log.Printf("[DEBUG] Creating MediaLive Channel: %s", input)
+	output, err := conn.CreateChannel(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating MediaLive Channel (%s): %w", name, err)
+	}
+
+	d.SetId(aws.StringValue(output.Channel.Id))
+
+	if _, err := waitChannelCreated(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) create: %w", d.Id(), err)
+	}
+
+	return resourceChannelRead(d, meta)
+}
+
+func resourceChannelRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	channel, err := FindChannelByID(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] MediaLive Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading MediaLive Channel (%s): %w", d.Id(), err)
+	}
+
+	d.Set("arn", channel.Arn)
+	d.Set("channel_class", channel.ChannelClass)
+	d.Set("channel_id", channel.Id)
+
+	if err := d.Set("destinations", flattenOutputDestinations(channel.Destinations)); err != nil {
+		return fmt.Errorf("error setting destinations: %w", err)
+	}
+
+	if err := d.Set("encoder_settings", flattenEncoderSettings(channel.EncoderSettings)); err != nil {
+		return fmt.Errorf("error setting encoder_settings: %w", err)
+	}
+
+	if err := d.Set("input_attachments", flattenInputAttachments(channel.InputAttachments)); err != nil {
+		return fmt.Errorf("error setting input_attachments: %w", err)
+	}
+
+	if err := d.Set("input_specification", flattenInputSpecification(channel.InputSpecification)); err != nil {
+		return fmt.Errorf("error setting input_specification: %w", err)
+	}
+
+	d.Set("log_level", channel.LogLevel)
+	d.Set("name", channel.Name)
+	d.Set("role_arn", channel.RoleArn)
+
+	if err := d.Set("vpc", flattenVPCOutputSettingsDescription(channel.Vpc, d.Get("vpc").([]interface{}))); err != nil {
+		return fmt.Errorf("error setting vpc: %w", err)
+	}
+
+	tags := KeyValueTags(channel.Tags).IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourceChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &medialive.UpdateChannelInput{
+			ChannelId:          aws.String(d.Id()),
+			Destinations:       expandOutputDestinations(d.Get("destinations").(*schema.Set).List()),
+			EncoderSettings:    expandEncoderSettings(d.Get("encoder_settings").([]interface{})),
+			InputAttachments:   expandInputAttachments(d.Get("input_attachments").([]interface{})),
+			InputSpecification: expandInputSpecification(d.Get("input_specification").([]interface{})),
+			Name:               aws.String(d.Get("name").(string)),
+		}
+
+		if v, ok := d.GetOk("log_level"); ok {
+			input.LogLevel = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("role_arn"); ok {
+			input.RoleArn = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating MediaLive Channel: %s", input)
+		_, err := conn.UpdateChannel(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating MediaLive Channel (%s): %w", d.Id(), err)
+		}
+
+		if _, err := waitChannelUpdated(conn, d.Id()); err != nil {
+			return fmt.Errorf("error waiting for MediaLive Channel (%s) update: %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating MediaLive Channel (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourceChannelRead(d, meta)
+}
+
+func resourceChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).MediaLiveConn
+
+	log.Printf("[DEBUG] Deleting MediaLive Channel: %s", d.Id())
+	_, err := conn.DeleteChannel(&medialive.DeleteChannelInput{
+		ChannelId: aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, medialive.ErrCodeNotFoundException) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting MediaLive Channel (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitChannelDeleted(conn, d.Id()); err != nil {
+		return fmt.Errorf("error waiting for MediaLive Channel (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandOutputDestinations(tfList []interface{}) []*medialive.OutputDestination {
+	var apiObjects []*medialive.OutputDestination
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.OutputDestination{}
+
+		if v, ok := tfMap["id"].(string); ok && v != "" {
+			apiObject.Id = aws.String(v)
+		}
+
+		if v, ok := tfMap["media_package_settings"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				apiObject.MediaPackageSettings = append(apiObject.MediaPackageSettings, &medialive.MediaPackageOutputDestinationSettings{
+					ChannelId: aws.String(tfMap["channel_id"].(string)),
+				})
+			}
+		}
+
+		if v, ok := tfMap["settings"].(*schema.Set); ok && v.Len() > 0 {
+			for _, tfMapRaw := range v.List() {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				settings := &medialive.OutputDestinationSettings{}
+
+				if v, ok := tfMap["password_param"].(string); ok && v != "" {
+					settings.PasswordParam = aws.String(v)
+				}
+
+				if v, ok := tfMap["stream_name"].(string); ok && v != "" {
+					settings.StreamName = aws.String(v)
+				}
+
+				if v, ok := tfMap["url"].(string); ok && v != "" {
+					settings.Url = aws.String(v)
+				}
+
+				if v, ok := tfMap["username"].(string); ok && v != "" {
+					settings.Username = aws.String(v)
+				}
+
+				apiObject.Settings = append(apiObject.Settings, settings)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandEncoderSettings(tfList []interface{}) *medialive.EncoderSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &medialive.EncoderSettings{}
+
+	if v, ok := tfMap["audio_descriptions"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.AudioDescriptions = expandAudioDescriptions(v.List())
+	}
+
+	if v, ok := tfMap["output_groups"].([]interface{}); ok && len(v) > 0 {
+		apiObject.OutputGroups = expandOutputGroups(v)
+	}
+
+	if v, ok := tfMap["timecode_config"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]interface{})
+		apiObject.TimecodeConfig = &medialive.TimecodeConfig{
+			Source: aws.String(tfMap["source"].(string)),
+		}
+
+		if v, ok := tfMap["sync_threshold"].(int); ok && v != 0 {
+			apiObject.TimecodeConfig.SyncThreshold = aws.Int64(int64(v))
+		}
+	}
+
+	if v, ok := tfMap["video_descriptions"].([]interface{}); ok && len(v) > 0 {
+		apiObject.VideoDescriptions = expandVideoDescriptions(v)
+	}
+
+	return apiObject
+}
+
+func expandAudioDescriptions(tfList []interface{}) []*medialive.AudioDescription {
+	var apiObjects []*medialive.AudioDescription
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.AudioDescription{
+			AudioSelectorName: aws.String(tfMap["audio_selector_name"].(string)),
+			Name:              aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["language_code"].(string); ok && v != "" {
+			apiObject.LanguageCode = aws.String(v)
+		}
+
+		if v, ok := tfMap["stream_name"].(string); ok && v != "" {
+			apiObject.StreamName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputGroups(tfList []interface{}) []*medialive.OutputGroup {
+	var apiObjects []*medialive.OutputGroup
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.OutputGroup{}
+
+		if v, ok := tfMap["name"].(string); ok && v != "" {
+			apiObject.Name = aws.String(v)
+		}
+
+		if v, ok := tfMap["output_group_settings"].([]interface{}); ok {
+			apiObject.OutputGroupSettings = expandOutputGroupSettings(v)
+		}
+
+		if v, ok := tfMap["outputs"].([]interface{}); ok && len(v) > 0 {
+			apiObject.Outputs = expandOutputs(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandOutputLocationRef(tfList []interface{}) *medialive.OutputLocationRef {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &medialive.OutputLocationRef{
+		DestinationRefId: aws.String(tfMap["destination_ref_id"].(string)),
+	}
+}
+
+func expandOutputs(tfList []interface{}) []*medialive.Output {
+	var apiObjects []*medialive.Output
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.Output{}
+
+		if v, ok := tfMap["audio_description_names"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.AudioDescriptionNames = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["caption_description_names"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.CaptionDescriptionNames = flex.ExpandStringSet(v)
+		}
+
+		if v, ok := tfMap["output_name"].(string); ok && v != "" {
+			apiObject.OutputName = aws.String(v)
+		}
+
+		if v, ok := tfMap["output_settings"].([]interface{}); ok {
+			apiObject.OutputSettings = expandOutputSettings(v)
+		}
+
+		if v, ok := tfMap["video_description_name"].(string); ok && v != "" {
+			apiObject.VideoDescriptionName = aws.String(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandVideoDescriptions(tfList []interface{}) []*medialive.VideoDescription {
+	var apiObjects []*medialive.VideoDescription
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.VideoDescription{
+			Name: aws.String(tfMap["name"].(string)),
+		}
+
+		if v, ok := tfMap["height"].(int); ok && v != 0 {
+			apiObject.Height = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["respond_to_afd"].(string); ok && v != "" {
+			apiObject.RespondToAfd = aws.String(v)
+		}
+
+		if v, ok := tfMap["scaling_behavior"].(string); ok && v != "" {
+			apiObject.ScalingBehavior = aws.String(v)
+		}
+
+		if v, ok := tfMap["sharpness"].(int); ok && v != 0 {
+			apiObject.Sharpness = aws.Int64(int64(v))
+		}
+
+		if v, ok := tfMap["width"].(int); ok && v != 0 {
+			apiObject.Width = aws.Int64(int64(v))
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandInputAttachments(tfList []interface{}) []*medialive.InputAttachment {
+	var apiObjects []*medialive.InputAttachment
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, &medialive.InputAttachment{
+			InputAttachmentName: aws.String(tfMap["input_attachment_name"].(string)),
+			InputId:             aws.String(tfMap["input_id"].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func expandInputSpecification(tfList []interface{}) *medialive.InputSpecification {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+
+	return &medialive.InputSpecification{
+		Codec:          aws.String(tfMap["codec"].(string)),
+		MaximumBitrate: aws.String(tfMap["maximum_bitrate"].(string)),
+		Resolution:     aws.String(tfMap["input_resolution"].(string)),
+	}
+}
+
+func expandVPCOutputSettings(tfList []interface{}) *medialive.VpcOutputSettings {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]interface{})
+	apiObject := &medialive.VpcOutputSettings{}
+
+	if v, ok := tfMap["public_address_allocation_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.PublicAddressAllocationIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["security_group_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SecurityGroupIds = flex.ExpandStringSet(v)
+	}
+
+	if v, ok := tfMap["subnet_ids"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.SubnetIds = flex.ExpandStringSet(v)
+	}
+
+	return apiObject
+}
+
+func flattenOutputDestinations(apiObjects []*medialive.OutputDestination) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		var mediaPackageSettings []interface{}
+		for _, v := range apiObject.MediaPackageSettings {
+			if v == nil {
+				continue
+			}
+
+			mediaPackageSettings = append(mediaPackageSettings, map[string]interface{}{
+				"channel_id": aws.StringValue(v.ChannelId),
+			})
+		}
+
+		var settings []interface{}
+		for _, v := range apiObject.Settings {
+			if v == nil {
+				continue
+			}
+
+			settings = append(settings, map[string]interface{}{
+				"password_param": aws.StringValue(v.PasswordParam),
+				"stream_name":    aws.StringValue(v.StreamName),
+				"url":            aws.StringValue(v.Url),
+				"username":       aws.StringValue(v.Username),
+			})
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"id":                     aws.StringValue(apiObject.Id),
+			"media_package_settings": mediaPackageSettings,
+			"settings":               settings,
+		})
+	}
+
+	return tfList
+}
+
+func flattenEncoderSettings(apiObject *medialive.EncoderSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"audio_descriptions": flattenAudioDescriptions(apiObject.AudioDescriptions),
+		"output_groups":      flattenOutputGroups(apiObject.OutputGroups),
+		"video_descriptions": flattenVideoDescriptions(apiObject.VideoDescriptions),
+	}
+
+	if v := apiObject.TimecodeConfig; v != nil {
+		tfMap["timecode_config"] = []interface{}{map[string]interface{}{
+			"source":         aws.StringValue(v.Source),
+			"sync_threshold": aws.Int64Value(v.SyncThreshold),
+		}}
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAudioDescriptions(apiObjects []*medialive.AudioDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"audio_selector_name": aws.StringValue(apiObject.AudioSelectorName),
+			"language_code":       aws.StringValue(apiObject.LanguageCode),
+			"name":                aws.StringValue(apiObject.Name),
+			"stream_name":         aws.StringValue(apiObject.StreamName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputGroups(apiObjects []*medialive.OutputGroup) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"name":                  aws.StringValue(apiObject.Name),
+			"output_group_settings": flattenOutputGroupSettings(apiObject.OutputGroupSettings),
+			"outputs":               flattenOutputs(apiObject.Outputs),
+		})
+	}
+
+	return tfList
+}
+
+func flattenOutputLocationRef(apiObject *medialive.OutputLocationRef) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"destination_ref_id": aws.StringValue(apiObject.DestinationRefId),
+	}}
+}
+
+func flattenOutputs(apiObjects []*medialive.Output) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"audio_description_names":   flex.FlattenStringSet(apiObject.AudioDescriptionNames),
+			"caption_description_names": flex.FlattenStringSet(apiObject.CaptionDescriptionNames),
+			"output_name":               aws.StringValue(apiObject.OutputName),
+			"output_settings":           flattenOutputSettings(apiObject.OutputSettings),
+			"video_description_name":    aws.StringValue(apiObject.VideoDescriptionName),
+		})
+	}
+
+	return tfList
+}
+
+func flattenVideoDescriptions(apiObjects []*medialive.VideoDescription) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"height":           aws.Int64Value(apiObject.Height),
+			"name":             aws.StringValue(apiObject.Name),
+			"respond_to_afd":   aws.StringValue(apiObject.RespondToAfd),
+			"scaling_behavior": aws.StringValue(apiObject.ScalingBehavior),
+			"sharpness":        aws.Int64Value(apiObject.Sharpness),
+			"width":            aws.Int64Value(apiObject.Width),
+		})
+	}
+
+	return tfList
+}
+
+func flattenInputAttachments(apiObjects []*medialive.InputAttachment) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"input_attachment_name": aws.StringValue(apiObject.InputAttachmentName),
+			"input_id":              aws.StringValue(apiObject.InputId),
+		})
+	}
+
+	return tfList
+}
+
+func flattenInputSpecification(apiObject *medialive.InputSpecification) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"codec":            aws.StringValue(apiObject.Codec),
+		"input_resolution": aws.StringValue(apiObject.Resolution),
+		"maximum_bitrate":  aws.StringValue(apiObject.MaximumBitrate),
+	}}
+}
+
+func flattenVPCOutputSettingsDescription(apiObject *medialive.VpcOutputSettingsDescription, tfList []interface{}) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"availability_zones":    flex.FlattenStringSet(apiObject.AvailabilityZones),
+		"network_interface_ids": flex.FlattenStringSet(apiObject.NetworkInterfaceIds),
+		"security_group_ids":    flex.FlattenStringSet(apiObject.SecurityGroupIds),
+		"subnet_ids":            flex.FlattenStringSet(apiObject.SubnetIds),
+	}
+
+	// Elastic IP allocations are not returned by the API.
+	if len(tfList) > 0 && tfList[0] != nil {
+		tfMap["public_address_allocation_ids"] = tfList[0].(map[string]interface{})["public_address_allocation_ids"]
+	}
+
+	return []interface{}{tfMap}
+}
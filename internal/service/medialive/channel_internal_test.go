@@ -0,0 +1,325 @@
+package medialive
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+)
+
+func TestOutputGroupsRoundTrip(t *testing.T) {
+	destination := &medialive.OutputLocationRef{
+		DestinationRefId: aws.String("destination"),
+	}
+
+	testCases := []struct {
+		TestName    string
+		OutputGroup *medialive.OutputGroup
+	}{
+		{
+			TestName: "archive",
+			OutputGroup: &medialive.OutputGroup{
+				Name: aws.String("archive"),
+				OutputGroupSettings: &medialive.OutputGroupSettings{
+					ArchiveGroupSettings: &medialive.ArchiveGroupSettings{
+						ArchiveCdnSettings: &medialive.ArchiveCdnSettings{
+							ArchiveS3Settings: &medialive.ArchiveS3Settings{
+								CannedAcl: aws.String(medialive.S3CannedAclBucketOwnerFullControl),
+							},
+						},
+						Destination:      destination,
+						RolloverInterval: aws.Int64(300),
+					},
+				},
+				Outputs: []*medialive.Output{{
+					OutputName: aws.String("output"),
+					OutputSettings: &medialive.OutputSettings{
+						ArchiveOutputSettings: &medialive.ArchiveOutputSettings{
+							ContainerSettings: &medialive.ArchiveContainerSettings{
+								M2tsSettings: &medialive.M2tsSettings{
+									AudioPids:    aws.String("482-498"),
+									Bitrate:      aws.Int64(5000000),
+									FragmentTime: aws.Float64(2.5),
+									RateMode:     aws.String(medialive.M2tsRateModeCbr),
+								},
+							},
+							Extension:    aws.String("m2ts"),
+							NameModifier: aws.String("-archive"),
+						},
+					},
+				}},
+			},
+		},
+		{
+			TestName: "frame capture",
+			OutputGroup: &medialive.OutputGroup{
+				OutputGroupSettings: &medialive.OutputGroupSettings{
+					FrameCaptureGroupSettings: &medialive.FrameCaptureGroupSettings{
+						Destination: destination,
+					},
+				},
+				Outputs: []*medialive.Output{{
+					OutputName: aws.String("output"),
+					OutputSettings: &medialive.OutputSettings{
+						FrameCaptureOutputSettings: &medialive.FrameCaptureOutputSettings{
+							NameModifier: aws.String("-frame"),
+						},
+					},
+				}},
+			},
+		},
+		{
+			TestName: "HLS",
+			OutputGroup: &medialive.OutputGroup{
+				OutputGroupSettings: &medialive.OutputGroupSettings{
+					HlsGroupSettings: &medialive.HlsGroupSettings{
+						AdMarkers: aws.StringSlice([]string{medialive.HlsAdMarkersElemental}),
+						CaptionLanguageMappings: []*medialive.CaptionLanguageMapping{{
+							CaptionChannel:      aws.Int64(1),
+							LanguageCode:        aws.String("eng"),
+							LanguageDescription: aws.String("English"),
+						}},
+						Destination: destination,
+						HlsCdnSettings: &medialive.HlsCdnSettings{
+							HlsMediaStoreSettings: &medialive.HlsMediaStoreSettings{
+								MediaStoreStorageClass: aws.String(medialive.HlsMediaStoreStorageClassTemporal),
+								NumRetries:             aws.Int64(10),
+							},
+						},
+						Mode:          aws.String(medialive.HlsModeLive),
+						SegmentLength: aws.Int64(6),
+					},
+				},
+				Outputs: []*medialive.Output{
+					{
+						OutputName: aws.String("video"),
+						OutputSettings: &medialive.OutputSettings{
+							HlsOutputSettings: &medialive.HlsOutputSettings{
+								H265PackagingType: aws.String(medialive.HlsH265PackagingTypeHvc1),
+								HlsSettings: &medialive.HlsSettings{
+									StandardHlsSettings: &medialive.StandardHlsSettings{
+										AudioRenditionSets: aws.String("program_audio"),
+										M3u8Settings: &medialive.M3u8Settings{
+											PcrControl: aws.String(medialive.M3u8PcrControlPcrEveryPesPacket),
+											ProgramNum: aws.Int64(1),
+										},
+									},
+								},
+								NameModifier: aws.String("_720p"),
+							},
+						},
+					},
+					{
+						OutputName: aws.String("audio"),
+						OutputSettings: &medialive.OutputSettings{
+							HlsOutputSettings: &medialive.HlsOutputSettings{
+								HlsSettings: &medialive.HlsSettings{
+									AudioOnlyHlsSettings: &medialive.AudioOnlyHlsSettings{
+										AudioGroupId: aws.String("program_audio"),
+										AudioOnlyImage: &medialive.InputLocation{
+											Uri: aws.String("s3://example/image.png"),
+										},
+										AudioTrackType: aws.String(medialive.AudioOnlyHlsTrackTypeAlternateAudioAutoSelect),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			TestName: "MediaPackage",
+			OutputGroup: &medialive.OutputGroup{
+				OutputGroupSettings: &medialive.OutputGroupSettings{
+					MediaPackageGroupSettings: &medialive.MediaPackageGroupSettings{
+						Destination: destination,
+					},
+				},
+				Outputs: []*medialive.Output{{
+					OutputName: aws.String("output"),
+					OutputSettings: &medialive.OutputSettings{
+						MediaPackageOutputSettings: &medialive.MediaPackageOutputSettings{},
+					},
+				}},
+			},
+		},
+		{
+			TestName: "Microsoft Smooth",
+			OutputGroup: &medialive.OutputGroup{
+				OutputGroupSettings: &medialive.OutputGroupSettings{
+					MsSmoothGroupSettings: &medialive.MsSmoothGroupSettings{
+						Destination:      destination,
+						FragmentLength:   aws.Int64(2),
+						SegmentationMode: aws.String(medialive.SmoothGroupSegmentationModeUseInputSegmentation),
+					},
+				},
+				Outputs: []*medialive.Output{{
+					OutputName: aws.String("output"),
+					OutputSettings: &medialive.OutputSettings{
+						MsSmoothOutputSettings: &medialive.MsSmoothOutputSettings{
+							NameModifier: aws.String("-smooth"),
+						},
+					},
+				}},
+			},
+		},
+		{
+			TestName: "RTMP",
+			OutputGroup: &medialive.OutputGroup{
+				OutputGroupSettings: &medialive.OutputGroupSettings{
+					RtmpGroupSettings: &medialive.RtmpGroupSettings{
+						CacheFullBehavior: aws.String(medialive.RtmpCacheFullBehaviorDisconnectImmediately),
+						RestartDelay:      aws.Int64(15),
+					},
+				},
+				Outputs: []*medialive.Output{{
+					OutputName: aws.String("output"),
+					OutputSettings: &medialive.OutputSettings{
+						RtmpOutputSettings: &medialive.RtmpOutputSettings{
+							CertificateMode: aws.String(medialive.RtmpOutputCertificateModeVerifyAuthenticity),
+							Destination:     destination,
+							NumRetries:      aws.Int64(3),
+						},
+					},
+				}},
+			},
+		},
+		{
+			TestName: "UDP",
+			OutputGroup: &medialive.OutputGroup{
+				OutputGroupSettings: &medialive.OutputGroupSettings{
+					UdpGroupSettings: &medialive.UdpGroupSettings{
+						InputLossAction: aws.String(medialive.InputLossActionForUdpOutDropTs),
+					},
+				},
+				Outputs: []*medialive.Output{{
+					OutputName: aws.String("output"),
+					OutputSettings: &medialive.OutputSettings{
+						UdpOutputSettings: &medialive.UdpOutputSettings{
+							BufferMsec: aws.Int64(1000),
+							ContainerSettings: &medialive.UdpContainerSettings{
+								M2tsSettings: &medialive.M2tsSettings{
+									RateMode: aws.String(medialive.M2tsRateModeCbr),
+								},
+							},
+							Destination: destination,
+							FecOutputSettings: &medialive.FecOutputSettings{
+								IncludeFec: aws.String(medialive.FecOutputIncludeFecColumnAndRow),
+								RowLength:  aws.Int64(20),
+							},
+						},
+					},
+				}},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			want := []*medialive.OutputGroup{testCase.OutputGroup}
+
+			// Round trip through the resource schema, as Read and Create/Update do.
+			d := ResourceChannel().TestResourceData()
+
+			if err := d.Set("encoder_settings", []interface{}{map[string]interface{}{
+				"output_groups": flattenOutputGroups(want),
+			}}); err != nil {
+				t.Fatalf("error setting encoder_settings: %s", err)
+			}
+
+			tfList := d.Get("encoder_settings.0.output_groups").([]interface{})
+
+			if err := validateOutputGroups(tfList); err != nil {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if got := expandOutputGroups(tfList); !reflect.DeepEqual(got, want) {
+				t.Errorf("got %s, expected %s", got, want)
+			}
+		})
+	}
+}
+
+func TestValidateOutputGroups(t *testing.T) {
+	destination := []interface{}{map[string]interface{}{
+		"destination_ref_id": "destination",
+	}}
+
+	testCases := []struct {
+		TestName      string
+		GroupSettings map[string]interface{}
+		Settings      map[string]interface{}
+		ExpectedError bool
+	}{
+		{
+			TestName: "one of each",
+			GroupSettings: map[string]interface{}{
+				"media_package_group_settings": []interface{}{map[string]interface{}{
+					"destination": destination,
+				}},
+			},
+			Settings: map[string]interface{}{
+				"media_package_output_settings": []interface{}{nil},
+			},
+		},
+		{
+			TestName:      "no group settings",
+			GroupSettings: map[string]interface{}{},
+			Settings: map[string]interface{}{
+				"media_package_output_settings": []interface{}{nil},
+			},
+			ExpectedError: true,
+		},
+		{
+			TestName: "two group settings",
+			GroupSettings: map[string]interface{}{
+				"hls_group_settings": []interface{}{map[string]interface{}{
+					"destination": destination,
+				}},
+				"media_package_group_settings": []interface{}{map[string]interface{}{
+					"destination": destination,
+				}},
+			},
+			Settings: map[string]interface{}{
+				"media_package_output_settings": []interface{}{nil},
+			},
+			ExpectedError: true,
+		},
+		{
+			TestName: "two output settings",
+			GroupSettings: map[string]interface{}{
+				"udp_group_settings": []interface{}{nil},
+			},
+			Settings: map[string]interface{}{
+				"rtmp_output_settings": []interface{}{map[string]interface{}{
+					"destination": destination,
+				}},
+				"udp_output_settings": []interface{}{map[string]interface{}{
+					"destination": destination,
+				}},
+			},
+			ExpectedError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			err := validateOutputGroups([]interface{}{map[string]interface{}{
+				"output_group_settings": []interface{}{testCase.GroupSettings},
+				"outputs": []interface{}{map[string]interface{}{
+					"output_settings": []interface{}{testCase.Settings},
+				}},
+			}})
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+		})
+	}
+}
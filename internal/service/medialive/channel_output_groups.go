@@ -0,0 +1,3714 @@
+package medialive
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+// Each output group and output must configure exactly one of these settings blocks.
+// ExactlyOneOf can't express this because output_groups and outputs are unbounded lists.
+var (
+	outputGroupSettingsTypes = []string{
+		"archive_group_settings",
+		"frame_capture_group_settings",
+		"hls_group_settings",
+		"media_package_group_settings",
+		"ms_smooth_group_settings",
+		"rtmp_group_settings",
+		"udp_group_settings",
+	}
+
+	outputSettingsTypes = []string{
+		"archive_output_settings",
+		"frame_capture_output_settings",
+		"hls_output_settings",
+		"media_package_output_settings",
+		"ms_smooth_output_settings",
+		"rtmp_output_settings",
+		"udp_output_settings",
+	}
+)
+
+func validateOutputGroups(tfList []interface{}) error {
+	for i, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		if v, ok := tfMap["output_group_settings"].([]interface{}); ok && len(v) > 0 {
+			if err := validateExactlyOneSettings(v[0], outputGroupSettingsTypes); err != nil {
+				return fmt.Errorf("output_groups.%d.output_group_settings: %w", i, err)
+			}
+		}
+
+		if v, ok := tfMap["outputs"].([]interface{}); ok {
+			for j, tfMapRaw := range v {
+				tfMap, ok := tfMapRaw.(map[string]interface{})
+
+				if !ok {
+					continue
+				}
+
+				if v, ok := tfMap["output_settings"].([]interface{}); ok && len(v) > 0 {
+					if err := validateExactlyOneSettings(v[0], outputSettingsTypes); err != nil {
+						return fmt.Errorf("output_groups.%d.outputs.%d.output_settings: %w", i, j, err)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateExactlyOneSettings(tfMapRaw interface{}, keys []string) error {
+	tfMap, _ := tfMapRaw.(map[string]interface{})
+
+	var specified []string
+
+	for _, key := range keys {
+		if v, ok := tfMap[key].([]interface{}); ok && len(v) > 0 {
+			specified = append(specified, key)
+		}
+	}
+
+	if len(specified) != 1 {
+		return fmt.Errorf("exactly one of `%s` must be specified", strings.Join(keys, ","))
+	}
+
+	return nil
+}
+
+func outputGroupSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"archive_group_settings":       archiveGroupSettingsSchema(),
+				"frame_capture_group_settings": frameCaptureGroupSettingsSchema(),
+				"hls_group_settings":           hlsGroupSettingsSchema(),
+				"media_package_group_settings": mediaPackageGroupSettingsSchema(),
+				"ms_smooth_group_settings":     msSmoothGroupSettingsSchema(),
+				"rtmp_group_settings":          rtmpGroupSettingsSchema(),
+				"udp_group_settings":           udpGroupSettingsSchema(),
+			},
+		},
+	}
+}
+
+func archiveGroupSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"archive_cdn_settings": archiveCdnSettingsSchema(),
+				"destination":          destinationSchema(),
+				"rollover_interval": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func archiveCdnSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"archive_s3_settings": archiveS3SettingsSchema(),
+			},
+		},
+	}
+}
+
+func archiveS3SettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"canned_acl": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.S3CannedAcl_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func frameCaptureGroupSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination":                destinationSchema(),
+				"frame_capture_cdn_settings": frameCaptureCdnSettingsSchema(),
+			},
+		},
+	}
+}
+
+func frameCaptureCdnSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"frame_capture_s3_settings": frameCaptureS3SettingsSchema(),
+			},
+		},
+	}
+}
+
+func frameCaptureS3SettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"canned_acl": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.S3CannedAcl_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func hlsGroupSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ad_markers": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(medialive.HlsAdMarkers_Values(), false),
+					},
+				},
+				"base_url_content": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"base_url_content1": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"base_url_manifest": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"base_url_manifest1": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"caption_language_mappings": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"caption_channel": {
+								Type:     schema.TypeInt,
+								Required: true,
+							},
+							"language_code": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+							"language_description": {
+								Type:     schema.TypeString,
+								Required: true,
+							},
+						},
+					},
+				},
+				"caption_language_setting": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsCaptionLanguageSetting_Values(), false),
+				},
+				"client_cache": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsClientCache_Values(), false),
+				},
+				"codec_specification": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsCodecSpecification_Values(), false),
+				},
+				"constant_iv": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"destination": destinationSchema(),
+				"directory_structure": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsDirectoryStructure_Values(), false),
+				},
+				"discontinuity_tags": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsDiscontinuityTags_Values(), false),
+				},
+				"encryption_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsEncryptionType_Values(), false),
+				},
+				"hls_cdn_settings": hlsCdnSettingsSchema(),
+				"hls_id3_segment_tagging": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsId3SegmentTaggingState_Values(), false),
+				},
+				"i_frame_only_playlists": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.IFrameOnlyPlaylistType_Values(), false),
+				},
+				"incomplete_segment_behavior": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsIncompleteSegmentBehavior_Values(), false),
+				},
+				"index_nsegments": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"input_loss_action": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.InputLossActionForHlsOut_Values(), false),
+				},
+				"iv_in_manifest": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsIvInManifest_Values(), false),
+				},
+				"iv_source": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsIvSource_Values(), false),
+				},
+				"keep_segments": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"key_format": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"key_format_versions": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"manifest_compression": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsManifestCompression_Values(), false),
+				},
+				"manifest_duration_format": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsManifestDurationFormat_Values(), false),
+				},
+				"min_segment_length": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsMode_Values(), false),
+				},
+				"output_selection": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsOutputSelection_Values(), false),
+				},
+				"program_date_time": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsProgramDateTime_Values(), false),
+				},
+				"program_date_time_period": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"redundant_manifest": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsRedundantManifest_Values(), false),
+				},
+				"segment_length": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"segmentation_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsSegmentationMode_Values(), false),
+				},
+				"segments_per_subdirectory": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"stream_inf_resolution": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsStreamInfResolution_Values(), false),
+				},
+				"timed_metadata_id3_frame": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsTimedMetadataId3Frame_Values(), false),
+				},
+				"timed_metadata_id3_period": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"timestamp_delta_milliseconds": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"ts_file_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsTsFileMode_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func hlsCdnSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"hls_akamai_settings":      hlsAkamaiSettingsSchema(),
+				"hls_basic_put_settings":   hlsBasicPutSettingsSchema(),
+				"hls_media_store_settings": hlsMediaStoreSettingsSchema(),
+				"hls_s3_settings":          hlsS3SettingsSchema(),
+				"hls_webdav_settings":      hlsWebdavSettingsSchema(),
+			},
+		},
+	}
+}
+
+func hlsAkamaiSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"connection_retry_interval": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"filecache_duration": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"http_transfer_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsAkamaiHttpTransferMode_Values(), false),
+				},
+				"num_retries": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"restart_delay": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"salt": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"token": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func hlsBasicPutSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"connection_retry_interval": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"filecache_duration": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"num_retries": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"restart_delay": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func hlsMediaStoreSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"connection_retry_interval": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"filecache_duration": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"media_store_storage_class": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsMediaStoreStorageClass_Values(), false),
+				},
+				"num_retries": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"restart_delay": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func hlsS3SettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"canned_acl": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.S3CannedAcl_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func hlsWebdavSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"connection_retry_interval": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"filecache_duration": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"http_transfer_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsWebdavHttpTransferMode_Values(), false),
+				},
+				"num_retries": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"restart_delay": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func mediaPackageGroupSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"destination": destinationSchema(),
+			},
+		},
+	}
+}
+
+func msSmoothGroupSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"acquisition_point_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"audio_only_timecode_control": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.SmoothGroupAudioOnlyTimecodeControl_Values(), false),
+				},
+				"certificate_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.SmoothGroupCertificateMode_Values(), false),
+				},
+				"connection_retry_interval": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"destination": destinationSchema(),
+				"event_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"event_id_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.SmoothGroupEventIdMode_Values(), false),
+				},
+				"event_stop_behavior": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.SmoothGroupEventStopBehavior_Values(), false),
+				},
+				"filecache_duration": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"fragment_length": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"input_loss_action": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.InputLossActionForMsSmoothOut_Values(), false),
+				},
+				"num_retries": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"restart_delay": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"segmentation_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.SmoothGroupSegmentationMode_Values(), false),
+				},
+				"send_delay_ms": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"sparse_track_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.SmoothGroupSparseTrackType_Values(), false),
+				},
+				"stream_manifest_behavior": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.SmoothGroupStreamManifestBehavior_Values(), false),
+				},
+				"timestamp_offset": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"timestamp_offset_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.SmoothGroupTimestampOffsetMode_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func rtmpGroupSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"ad_markers": {
+					Type:     schema.TypeList,
+					Optional: true,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type:         schema.TypeString,
+						ValidateFunc: validation.StringInSlice(medialive.RtmpAdMarkers_Values(), false),
+					},
+				},
+				"authentication_scheme": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.AuthenticationScheme_Values(), false),
+				},
+				"cache_full_behavior": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.RtmpCacheFullBehavior_Values(), false),
+				},
+				"cache_length": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"caption_data": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.RtmpCaptionData_Values(), false),
+				},
+				"input_loss_action": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.InputLossActionForRtmpOut_Values(), false),
+				},
+				"restart_delay": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func udpGroupSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"input_loss_action": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.InputLossActionForUdpOut_Values(), false),
+				},
+				"timed_metadata_id3_frame": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.UdpTimedMetadataId3Frame_Values(), false),
+				},
+				"timed_metadata_id3_period": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func outputSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"archive_output_settings":       archiveOutputSettingsSchema(),
+				"frame_capture_output_settings": frameCaptureOutputSettingsSchema(),
+				"hls_output_settings":           hlsOutputSettingsSchema(),
+				"media_package_output_settings": mediaPackageOutputSettingsSchema(),
+				"ms_smooth_output_settings":     msSmoothOutputSettingsSchema(),
+				"rtmp_output_settings":          rtmpOutputSettingsSchema(),
+				"udp_output_settings":           udpOutputSettingsSchema(),
+			},
+		},
+	}
+}
+
+func archiveOutputSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"container_settings": archiveContainerSettingsSchema(),
+				"extension": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"name_modifier": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func archiveContainerSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"m2ts_settings": m2tsSettingsSchema(),
+				"raw_settings":  rawSettingsSchema(),
+			},
+		},
+	}
+}
+
+func m2tsSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"absent_input_audio_behavior": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsAbsentInputAudioBehavior_Values(), false),
+				},
+				"arib": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsArib_Values(), false),
+				},
+				"arib_captions_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"arib_captions_pid_control": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsAribCaptionsPidControl_Values(), false),
+				},
+				"audio_buffer_model": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsAudioBufferModel_Values(), false),
+				},
+				"audio_frames_per_pes": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"audio_pids": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"audio_stream_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsAudioStreamType_Values(), false),
+				},
+				"bitrate": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"buffer_model": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsBufferModel_Values(), false),
+				},
+				"cc_descriptor": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsCcDescriptor_Values(), false),
+				},
+				"dvb_sub_pids": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"dvb_teletext_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"ebif": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsEbifControl_Values(), false),
+				},
+				"ebp_audio_interval": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsAudioInterval_Values(), false),
+				},
+				"ebp_lookahead_ms": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"ebp_placement": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsEbpPlacement_Values(), false),
+				},
+				"ecm_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"es_rate_in_pes": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsEsRateInPes_Values(), false),
+				},
+				"etv_platform_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"etv_signal_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"fragment_time": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+					Computed: true,
+				},
+				"klv": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsKlv_Values(), false),
+				},
+				"klv_data_pids": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"nielsen_id3_behavior": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsNielsenId3Behavior_Values(), false),
+				},
+				"null_packet_bitrate": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+					Computed: true,
+				},
+				"pat_interval": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"pcr_control": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsPcrControl_Values(), false),
+				},
+				"pcr_period": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"pcr_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"pmt_interval": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"pmt_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"program_num": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"rate_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsRateMode_Values(), false),
+				},
+				"scte27_pids": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"scte35_control": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsScte35Control_Values(), false),
+				},
+				"scte35_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"segmentation_markers": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsSegmentationMarkers_Values(), false),
+				},
+				"segmentation_style": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsSegmentationStyle_Values(), false),
+				},
+				"segmentation_time": {
+					Type:     schema.TypeFloat,
+					Optional: true,
+					Computed: true,
+				},
+				"timed_metadata_behavior": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M2tsTimedMetadataBehavior_Values(), false),
+				},
+				"timed_metadata_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"transport_stream_id": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"video_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func rawSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{},
+		},
+	}
+}
+
+func frameCaptureOutputSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name_modifier": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func hlsOutputSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"h265_packaging_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.HlsH265PackagingType_Values(), false),
+				},
+				"hls_settings": hlsSettingsSchema(),
+				"name_modifier": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"segment_modifier": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func hlsSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"audio_only_hls_settings":    audioOnlyHlsSettingsSchema(),
+				"fmp4_hls_settings":          fmp4HlsSettingsSchema(),
+				"frame_capture_hls_settings": frameCaptureHlsSettingsSchema(),
+				"standard_hls_settings":      standardHlsSettingsSchema(),
+			},
+		},
+	}
+}
+
+func audioOnlyHlsSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"audio_group_id": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"audio_only_image": inputLocationSchema(),
+				"audio_track_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.AudioOnlyHlsTrackType_Values(), false),
+				},
+				"segment_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.AudioOnlyHlsSegmentType_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func inputLocationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"password_param": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"uri": {
+					Type:     schema.TypeString,
+					Required: true,
+				},
+				"username": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func fmp4HlsSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"audio_rendition_sets": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"nielsen_id3_behavior": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.Fmp4NielsenId3Behavior_Values(), false),
+				},
+				"timed_metadata_behavior": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.Fmp4TimedMetadataBehavior_Values(), false),
+				},
+			},
+		},
+	}
+}
+
+func frameCaptureHlsSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{},
+		},
+	}
+}
+
+func standardHlsSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"audio_rendition_sets": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"m3u8_settings": m3u8SettingsSchema(),
+			},
+		},
+	}
+}
+
+func m3u8SettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"audio_frames_per_pes": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"audio_pids": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"ecm_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"nielsen_id3_behavior": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M3u8NielsenId3Behavior_Values(), false),
+				},
+				"pat_interval": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"pcr_control": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M3u8PcrControl_Values(), false),
+				},
+				"pcr_period": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"pcr_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"pmt_interval": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"pmt_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"program_num": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"scte35_behavior": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M3u8Scte35Behavior_Values(), false),
+				},
+				"scte35_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"timed_metadata_behavior": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.M3u8TimedMetadataBehavior_Values(), false),
+				},
+				"timed_metadata_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+				"transport_stream_id": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"video_pid": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func mediaPackageOutputSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{},
+		},
+	}
+}
+
+func msSmoothOutputSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"h265_packaging_type": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.MsSmoothH265PackagingType_Values(), false),
+				},
+				"name_modifier": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func rtmpOutputSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"certificate_mode": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.RtmpOutputCertificateMode_Values(), false),
+				},
+				"connection_retry_interval": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"destination": destinationSchema(),
+				"num_retries": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func udpOutputSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"buffer_msec": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"container_settings":  udpContainerSettingsSchema(),
+				"destination":         destinationSchema(),
+				"fec_output_settings": fecOutputSettingsSchema(),
+			},
+		},
+	}
+}
+
+func udpContainerSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"m2ts_settings": m2tsSettingsSchema(),
+			},
+		},
+	}
+}
+
+func fecOutputSettingsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"column_depth": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+				"include_fec": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.StringInSlice(medialive.FecOutputIncludeFec_Values(), false),
+				},
+				"row_length": {
+					Type:     schema.TypeInt,
+					Optional: true,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+func expandOutputGroupSettings(tfList []interface{}) *medialive.OutputGroupSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.OutputGroupSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["archive_group_settings"].([]interface{}); ok {
+		apiObject.ArchiveGroupSettings = expandArchiveGroupSettings(v)
+	}
+
+	if v, ok := tfMap["frame_capture_group_settings"].([]interface{}); ok {
+		apiObject.FrameCaptureGroupSettings = expandFrameCaptureGroupSettings(v)
+	}
+
+	if v, ok := tfMap["hls_group_settings"].([]interface{}); ok {
+		apiObject.HlsGroupSettings = expandHlsGroupSettings(v)
+	}
+
+	if v, ok := tfMap["media_package_group_settings"].([]interface{}); ok {
+		apiObject.MediaPackageGroupSettings = expandMediaPackageGroupSettings(v)
+	}
+
+	if v, ok := tfMap["ms_smooth_group_settings"].([]interface{}); ok {
+		apiObject.MsSmoothGroupSettings = expandMsSmoothGroupSettings(v)
+	}
+
+	if v, ok := tfMap["rtmp_group_settings"].([]interface{}); ok {
+		apiObject.RtmpGroupSettings = expandRtmpGroupSettings(v)
+	}
+
+	if v, ok := tfMap["udp_group_settings"].([]interface{}); ok {
+		apiObject.UdpGroupSettings = expandUdpGroupSettings(v)
+	}
+
+	return apiObject
+}
+
+func expandArchiveGroupSettings(tfList []interface{}) *medialive.ArchiveGroupSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.ArchiveGroupSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["archive_cdn_settings"].([]interface{}); ok {
+		apiObject.ArchiveCdnSettings = expandArchiveCdnSettings(v)
+	}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok {
+		apiObject.Destination = expandOutputLocationRef(v)
+	}
+
+	if v, ok := tfMap["rollover_interval"].(int); ok && v != 0 {
+		apiObject.RolloverInterval = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandArchiveCdnSettings(tfList []interface{}) *medialive.ArchiveCdnSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.ArchiveCdnSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["archive_s3_settings"].([]interface{}); ok {
+		apiObject.ArchiveS3Settings = expandArchiveS3Settings(v)
+	}
+
+	return apiObject
+}
+
+func expandArchiveS3Settings(tfList []interface{}) *medialive.ArchiveS3Settings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.ArchiveS3Settings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["canned_acl"].(string); ok && v != "" {
+		apiObject.CannedAcl = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandFrameCaptureGroupSettings(tfList []interface{}) *medialive.FrameCaptureGroupSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.FrameCaptureGroupSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok {
+		apiObject.Destination = expandOutputLocationRef(v)
+	}
+
+	if v, ok := tfMap["frame_capture_cdn_settings"].([]interface{}); ok {
+		apiObject.FrameCaptureCdnSettings = expandFrameCaptureCdnSettings(v)
+	}
+
+	return apiObject
+}
+
+func expandFrameCaptureCdnSettings(tfList []interface{}) *medialive.FrameCaptureCdnSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.FrameCaptureCdnSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["frame_capture_s3_settings"].([]interface{}); ok {
+		apiObject.FrameCaptureS3Settings = expandFrameCaptureS3Settings(v)
+	}
+
+	return apiObject
+}
+
+func expandFrameCaptureS3Settings(tfList []interface{}) *medialive.FrameCaptureS3Settings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.FrameCaptureS3Settings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["canned_acl"].(string); ok && v != "" {
+		apiObject.CannedAcl = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandHlsGroupSettings(tfList []interface{}) *medialive.HlsGroupSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.HlsGroupSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["ad_markers"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AdMarkers = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["base_url_content"].(string); ok && v != "" {
+		apiObject.BaseUrlContent = aws.String(v)
+	}
+
+	if v, ok := tfMap["base_url_content1"].(string); ok && v != "" {
+		apiObject.BaseUrlContent1 = aws.String(v)
+	}
+
+	if v, ok := tfMap["base_url_manifest"].(string); ok && v != "" {
+		apiObject.BaseUrlManifest = aws.String(v)
+	}
+
+	if v, ok := tfMap["base_url_manifest1"].(string); ok && v != "" {
+		apiObject.BaseUrlManifest1 = aws.String(v)
+	}
+
+	if v, ok := tfMap["caption_language_mappings"].([]interface{}); ok && len(v) > 0 {
+		apiObject.CaptionLanguageMappings = expandCaptionLanguageMappings(v)
+	}
+
+	if v, ok := tfMap["caption_language_setting"].(string); ok && v != "" {
+		apiObject.CaptionLanguageSetting = aws.String(v)
+	}
+
+	if v, ok := tfMap["client_cache"].(string); ok && v != "" {
+		apiObject.ClientCache = aws.String(v)
+	}
+
+	if v, ok := tfMap["codec_specification"].(string); ok && v != "" {
+		apiObject.CodecSpecification = aws.String(v)
+	}
+
+	if v, ok := tfMap["constant_iv"].(string); ok && v != "" {
+		apiObject.ConstantIv = aws.String(v)
+	}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok {
+		apiObject.Destination = expandOutputLocationRef(v)
+	}
+
+	if v, ok := tfMap["directory_structure"].(string); ok && v != "" {
+		apiObject.DirectoryStructure = aws.String(v)
+	}
+
+	if v, ok := tfMap["discontinuity_tags"].(string); ok && v != "" {
+		apiObject.DiscontinuityTags = aws.String(v)
+	}
+
+	if v, ok := tfMap["encryption_type"].(string); ok && v != "" {
+		apiObject.EncryptionType = aws.String(v)
+	}
+
+	if v, ok := tfMap["hls_cdn_settings"].([]interface{}); ok {
+		apiObject.HlsCdnSettings = expandHlsCdnSettings(v)
+	}
+
+	if v, ok := tfMap["hls_id3_segment_tagging"].(string); ok && v != "" {
+		apiObject.HlsId3SegmentTagging = aws.String(v)
+	}
+
+	if v, ok := tfMap["i_frame_only_playlists"].(string); ok && v != "" {
+		apiObject.IFrameOnlyPlaylists = aws.String(v)
+	}
+
+	if v, ok := tfMap["incomplete_segment_behavior"].(string); ok && v != "" {
+		apiObject.IncompleteSegmentBehavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["index_nsegments"].(int); ok && v != 0 {
+		apiObject.IndexNSegments = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["input_loss_action"].(string); ok && v != "" {
+		apiObject.InputLossAction = aws.String(v)
+	}
+
+	if v, ok := tfMap["iv_in_manifest"].(string); ok && v != "" {
+		apiObject.IvInManifest = aws.String(v)
+	}
+
+	if v, ok := tfMap["iv_source"].(string); ok && v != "" {
+		apiObject.IvSource = aws.String(v)
+	}
+
+	if v, ok := tfMap["keep_segments"].(int); ok && v != 0 {
+		apiObject.KeepSegments = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["key_format"].(string); ok && v != "" {
+		apiObject.KeyFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["key_format_versions"].(string); ok && v != "" {
+		apiObject.KeyFormatVersions = aws.String(v)
+	}
+
+	if v, ok := tfMap["manifest_compression"].(string); ok && v != "" {
+		apiObject.ManifestCompression = aws.String(v)
+	}
+
+	if v, ok := tfMap["manifest_duration_format"].(string); ok && v != "" {
+		apiObject.ManifestDurationFormat = aws.String(v)
+	}
+
+	if v, ok := tfMap["min_segment_length"].(int); ok && v != 0 {
+		apiObject.MinSegmentLength = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["mode"].(string); ok && v != "" {
+		apiObject.Mode = aws.String(v)
+	}
+
+	if v, ok := tfMap["output_selection"].(string); ok && v != "" {
+		apiObject.OutputSelection = aws.String(v)
+	}
+
+	if v, ok := tfMap["program_date_time"].(string); ok && v != "" {
+		apiObject.ProgramDateTime = aws.String(v)
+	}
+
+	if v, ok := tfMap["program_date_time_period"].(int); ok && v != 0 {
+		apiObject.ProgramDateTimePeriod = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["redundant_manifest"].(string); ok && v != "" {
+		apiObject.RedundantManifest = aws.String(v)
+	}
+
+	if v, ok := tfMap["segment_length"].(int); ok && v != 0 {
+		apiObject.SegmentLength = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["segmentation_mode"].(string); ok && v != "" {
+		apiObject.SegmentationMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["segments_per_subdirectory"].(int); ok && v != 0 {
+		apiObject.SegmentsPerSubdirectory = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["stream_inf_resolution"].(string); ok && v != "" {
+		apiObject.StreamInfResolution = aws.String(v)
+	}
+
+	if v, ok := tfMap["timed_metadata_id3_frame"].(string); ok && v != "" {
+		apiObject.TimedMetadataId3Frame = aws.String(v)
+	}
+
+	if v, ok := tfMap["timed_metadata_id3_period"].(int); ok && v != 0 {
+		apiObject.TimedMetadataId3Period = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["timestamp_delta_milliseconds"].(int); ok && v != 0 {
+		apiObject.TimestampDeltaMilliseconds = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["ts_file_mode"].(string); ok && v != "" {
+		apiObject.TsFileMode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandCaptionLanguageMappings(tfList []interface{}) []*medialive.CaptionLanguageMapping {
+	var apiObjects []*medialive.CaptionLanguageMapping
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		apiObject := &medialive.CaptionLanguageMapping{
+			CaptionChannel:      aws.Int64(int64(tfMap["caption_channel"].(int))),
+			LanguageCode:        aws.String(tfMap["language_code"].(string)),
+			LanguageDescription: aws.String(tfMap["language_description"].(string)),
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandHlsCdnSettings(tfList []interface{}) *medialive.HlsCdnSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.HlsCdnSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["hls_akamai_settings"].([]interface{}); ok {
+		apiObject.HlsAkamaiSettings = expandHlsAkamaiSettings(v)
+	}
+
+	if v, ok := tfMap["hls_basic_put_settings"].([]interface{}); ok {
+		apiObject.HlsBasicPutSettings = expandHlsBasicPutSettings(v)
+	}
+
+	if v, ok := tfMap["hls_media_store_settings"].([]interface{}); ok {
+		apiObject.HlsMediaStoreSettings = expandHlsMediaStoreSettings(v)
+	}
+
+	if v, ok := tfMap["hls_s3_settings"].([]interface{}); ok {
+		apiObject.HlsS3Settings = expandHlsS3Settings(v)
+	}
+
+	if v, ok := tfMap["hls_webdav_settings"].([]interface{}); ok {
+		apiObject.HlsWebdavSettings = expandHlsWebdavSettings(v)
+	}
+
+	return apiObject
+}
+
+func expandHlsAkamaiSettings(tfList []interface{}) *medialive.HlsAkamaiSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.HlsAkamaiSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["connection_retry_interval"].(int); ok && v != 0 {
+		apiObject.ConnectionRetryInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["filecache_duration"].(int); ok && v != 0 {
+		apiObject.FilecacheDuration = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["http_transfer_mode"].(string); ok && v != "" {
+		apiObject.HttpTransferMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["num_retries"].(int); ok && v != 0 {
+		apiObject.NumRetries = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["restart_delay"].(int); ok && v != 0 {
+		apiObject.RestartDelay = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["salt"].(string); ok && v != "" {
+		apiObject.Salt = aws.String(v)
+	}
+
+	if v, ok := tfMap["token"].(string); ok && v != "" {
+		apiObject.Token = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandHlsBasicPutSettings(tfList []interface{}) *medialive.HlsBasicPutSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.HlsBasicPutSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["connection_retry_interval"].(int); ok && v != 0 {
+		apiObject.ConnectionRetryInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["filecache_duration"].(int); ok && v != 0 {
+		apiObject.FilecacheDuration = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["num_retries"].(int); ok && v != 0 {
+		apiObject.NumRetries = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["restart_delay"].(int); ok && v != 0 {
+		apiObject.RestartDelay = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandHlsMediaStoreSettings(tfList []interface{}) *medialive.HlsMediaStoreSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.HlsMediaStoreSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["connection_retry_interval"].(int); ok && v != 0 {
+		apiObject.ConnectionRetryInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["filecache_duration"].(int); ok && v != 0 {
+		apiObject.FilecacheDuration = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["media_store_storage_class"].(string); ok && v != "" {
+		apiObject.MediaStoreStorageClass = aws.String(v)
+	}
+
+	if v, ok := tfMap["num_retries"].(int); ok && v != 0 {
+		apiObject.NumRetries = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["restart_delay"].(int); ok && v != 0 {
+		apiObject.RestartDelay = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandHlsS3Settings(tfList []interface{}) *medialive.HlsS3Settings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.HlsS3Settings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["canned_acl"].(string); ok && v != "" {
+		apiObject.CannedAcl = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandHlsWebdavSettings(tfList []interface{}) *medialive.HlsWebdavSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.HlsWebdavSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["connection_retry_interval"].(int); ok && v != 0 {
+		apiObject.ConnectionRetryInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["filecache_duration"].(int); ok && v != 0 {
+		apiObject.FilecacheDuration = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["http_transfer_mode"].(string); ok && v != "" {
+		apiObject.HttpTransferMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["num_retries"].(int); ok && v != 0 {
+		apiObject.NumRetries = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["restart_delay"].(int); ok && v != 0 {
+		apiObject.RestartDelay = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandMediaPackageGroupSettings(tfList []interface{}) *medialive.MediaPackageGroupSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.MediaPackageGroupSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok {
+		apiObject.Destination = expandOutputLocationRef(v)
+	}
+
+	return apiObject
+}
+
+func expandMsSmoothGroupSettings(tfList []interface{}) *medialive.MsSmoothGroupSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.MsSmoothGroupSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["acquisition_point_id"].(string); ok && v != "" {
+		apiObject.AcquisitionPointId = aws.String(v)
+	}
+
+	if v, ok := tfMap["audio_only_timecode_control"].(string); ok && v != "" {
+		apiObject.AudioOnlyTimecodeControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["certificate_mode"].(string); ok && v != "" {
+		apiObject.CertificateMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["connection_retry_interval"].(int); ok && v != 0 {
+		apiObject.ConnectionRetryInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok {
+		apiObject.Destination = expandOutputLocationRef(v)
+	}
+
+	if v, ok := tfMap["event_id"].(string); ok && v != "" {
+		apiObject.EventId = aws.String(v)
+	}
+
+	if v, ok := tfMap["event_id_mode"].(string); ok && v != "" {
+		apiObject.EventIdMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["event_stop_behavior"].(string); ok && v != "" {
+		apiObject.EventStopBehavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["filecache_duration"].(int); ok && v != 0 {
+		apiObject.FilecacheDuration = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["fragment_length"].(int); ok && v != 0 {
+		apiObject.FragmentLength = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["input_loss_action"].(string); ok && v != "" {
+		apiObject.InputLossAction = aws.String(v)
+	}
+
+	if v, ok := tfMap["num_retries"].(int); ok && v != 0 {
+		apiObject.NumRetries = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["restart_delay"].(int); ok && v != 0 {
+		apiObject.RestartDelay = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["segmentation_mode"].(string); ok && v != "" {
+		apiObject.SegmentationMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["send_delay_ms"].(int); ok && v != 0 {
+		apiObject.SendDelayMs = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["sparse_track_type"].(string); ok && v != "" {
+		apiObject.SparseTrackType = aws.String(v)
+	}
+
+	if v, ok := tfMap["stream_manifest_behavior"].(string); ok && v != "" {
+		apiObject.StreamManifestBehavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp_offset"].(string); ok && v != "" {
+		apiObject.TimestampOffset = aws.String(v)
+	}
+
+	if v, ok := tfMap["timestamp_offset_mode"].(string); ok && v != "" {
+		apiObject.TimestampOffsetMode = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRtmpGroupSettings(tfList []interface{}) *medialive.RtmpGroupSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.RtmpGroupSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["ad_markers"].([]interface{}); ok && len(v) > 0 {
+		apiObject.AdMarkers = flex.ExpandStringList(v)
+	}
+
+	if v, ok := tfMap["authentication_scheme"].(string); ok && v != "" {
+		apiObject.AuthenticationScheme = aws.String(v)
+	}
+
+	if v, ok := tfMap["cache_full_behavior"].(string); ok && v != "" {
+		apiObject.CacheFullBehavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["cache_length"].(int); ok && v != 0 {
+		apiObject.CacheLength = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["caption_data"].(string); ok && v != "" {
+		apiObject.CaptionData = aws.String(v)
+	}
+
+	if v, ok := tfMap["input_loss_action"].(string); ok && v != "" {
+		apiObject.InputLossAction = aws.String(v)
+	}
+
+	if v, ok := tfMap["restart_delay"].(int); ok && v != 0 {
+		apiObject.RestartDelay = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandUdpGroupSettings(tfList []interface{}) *medialive.UdpGroupSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.UdpGroupSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["input_loss_action"].(string); ok && v != "" {
+		apiObject.InputLossAction = aws.String(v)
+	}
+
+	if v, ok := tfMap["timed_metadata_id3_frame"].(string); ok && v != "" {
+		apiObject.TimedMetadataId3Frame = aws.String(v)
+	}
+
+	if v, ok := tfMap["timed_metadata_id3_period"].(int); ok && v != 0 {
+		apiObject.TimedMetadataId3Period = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandOutputSettings(tfList []interface{}) *medialive.OutputSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.OutputSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["archive_output_settings"].([]interface{}); ok {
+		apiObject.ArchiveOutputSettings = expandArchiveOutputSettings(v)
+	}
+
+	if v, ok := tfMap["frame_capture_output_settings"].([]interface{}); ok {
+		apiObject.FrameCaptureOutputSettings = expandFrameCaptureOutputSettings(v)
+	}
+
+	if v, ok := tfMap["hls_output_settings"].([]interface{}); ok {
+		apiObject.HlsOutputSettings = expandHlsOutputSettings(v)
+	}
+
+	if v, ok := tfMap["media_package_output_settings"].([]interface{}); ok {
+		apiObject.MediaPackageOutputSettings = expandMediaPackageOutputSettings(v)
+	}
+
+	if v, ok := tfMap["ms_smooth_output_settings"].([]interface{}); ok {
+		apiObject.MsSmoothOutputSettings = expandMsSmoothOutputSettings(v)
+	}
+
+	if v, ok := tfMap["rtmp_output_settings"].([]interface{}); ok {
+		apiObject.RtmpOutputSettings = expandRtmpOutputSettings(v)
+	}
+
+	if v, ok := tfMap["udp_output_settings"].([]interface{}); ok {
+		apiObject.UdpOutputSettings = expandUdpOutputSettings(v)
+	}
+
+	return apiObject
+}
+
+func expandArchiveOutputSettings(tfList []interface{}) *medialive.ArchiveOutputSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.ArchiveOutputSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["container_settings"].([]interface{}); ok {
+		apiObject.ContainerSettings = expandArchiveContainerSettings(v)
+	}
+
+	if v, ok := tfMap["extension"].(string); ok && v != "" {
+		apiObject.Extension = aws.String(v)
+	}
+
+	if v, ok := tfMap["name_modifier"].(string); ok && v != "" {
+		apiObject.NameModifier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandArchiveContainerSettings(tfList []interface{}) *medialive.ArchiveContainerSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.ArchiveContainerSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["m2ts_settings"].([]interface{}); ok {
+		apiObject.M2tsSettings = expandM2tsSettings(v)
+	}
+
+	if v, ok := tfMap["raw_settings"].([]interface{}); ok {
+		apiObject.RawSettings = expandRawSettings(v)
+	}
+
+	return apiObject
+}
+
+func expandM2tsSettings(tfList []interface{}) *medialive.M2tsSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.M2tsSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["absent_input_audio_behavior"].(string); ok && v != "" {
+		apiObject.AbsentInputAudioBehavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["arib"].(string); ok && v != "" {
+		apiObject.Arib = aws.String(v)
+	}
+
+	if v, ok := tfMap["arib_captions_pid"].(string); ok && v != "" {
+		apiObject.AribCaptionsPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["arib_captions_pid_control"].(string); ok && v != "" {
+		apiObject.AribCaptionsPidControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["audio_buffer_model"].(string); ok && v != "" {
+		apiObject.AudioBufferModel = aws.String(v)
+	}
+
+	if v, ok := tfMap["audio_frames_per_pes"].(int); ok && v != 0 {
+		apiObject.AudioFramesPerPes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["audio_pids"].(string); ok && v != "" {
+		apiObject.AudioPids = aws.String(v)
+	}
+
+	if v, ok := tfMap["audio_stream_type"].(string); ok && v != "" {
+		apiObject.AudioStreamType = aws.String(v)
+	}
+
+	if v, ok := tfMap["bitrate"].(int); ok && v != 0 {
+		apiObject.Bitrate = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["buffer_model"].(string); ok && v != "" {
+		apiObject.BufferModel = aws.String(v)
+	}
+
+	if v, ok := tfMap["cc_descriptor"].(string); ok && v != "" {
+		apiObject.CcDescriptor = aws.String(v)
+	}
+
+	if v, ok := tfMap["dvb_sub_pids"].(string); ok && v != "" {
+		apiObject.DvbSubPids = aws.String(v)
+	}
+
+	if v, ok := tfMap["dvb_teletext_pid"].(string); ok && v != "" {
+		apiObject.DvbTeletextPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["ebif"].(string); ok && v != "" {
+		apiObject.Ebif = aws.String(v)
+	}
+
+	if v, ok := tfMap["ebp_audio_interval"].(string); ok && v != "" {
+		apiObject.EbpAudioInterval = aws.String(v)
+	}
+
+	if v, ok := tfMap["ebp_lookahead_ms"].(int); ok && v != 0 {
+		apiObject.EbpLookaheadMs = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["ebp_placement"].(string); ok && v != "" {
+		apiObject.EbpPlacement = aws.String(v)
+	}
+
+	if v, ok := tfMap["ecm_pid"].(string); ok && v != "" {
+		apiObject.EcmPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["es_rate_in_pes"].(string); ok && v != "" {
+		apiObject.EsRateInPes = aws.String(v)
+	}
+
+	if v, ok := tfMap["etv_platform_pid"].(string); ok && v != "" {
+		apiObject.EtvPlatformPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["etv_signal_pid"].(string); ok && v != "" {
+		apiObject.EtvSignalPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["fragment_time"].(float64); ok && v != 0.0 {
+		apiObject.FragmentTime = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["klv"].(string); ok && v != "" {
+		apiObject.Klv = aws.String(v)
+	}
+
+	if v, ok := tfMap["klv_data_pids"].(string); ok && v != "" {
+		apiObject.KlvDataPids = aws.String(v)
+	}
+
+	if v, ok := tfMap["nielsen_id3_behavior"].(string); ok && v != "" {
+		apiObject.NielsenId3Behavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["null_packet_bitrate"].(float64); ok && v != 0.0 {
+		apiObject.NullPacketBitrate = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["pat_interval"].(int); ok && v != 0 {
+		apiObject.PatInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pcr_control"].(string); ok && v != "" {
+		apiObject.PcrControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["pcr_period"].(int); ok && v != 0 {
+		apiObject.PcrPeriod = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pcr_pid"].(string); ok && v != "" {
+		apiObject.PcrPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["pmt_interval"].(int); ok && v != 0 {
+		apiObject.PmtInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pmt_pid"].(string); ok && v != "" {
+		apiObject.PmtPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["program_num"].(int); ok && v != 0 {
+		apiObject.ProgramNum = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["rate_mode"].(string); ok && v != "" {
+		apiObject.RateMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["scte27_pids"].(string); ok && v != "" {
+		apiObject.Scte27Pids = aws.String(v)
+	}
+
+	if v, ok := tfMap["scte35_control"].(string); ok && v != "" {
+		apiObject.Scte35Control = aws.String(v)
+	}
+
+	if v, ok := tfMap["scte35_pid"].(string); ok && v != "" {
+		apiObject.Scte35Pid = aws.String(v)
+	}
+
+	if v, ok := tfMap["segmentation_markers"].(string); ok && v != "" {
+		apiObject.SegmentationMarkers = aws.String(v)
+	}
+
+	if v, ok := tfMap["segmentation_style"].(string); ok && v != "" {
+		apiObject.SegmentationStyle = aws.String(v)
+	}
+
+	if v, ok := tfMap["segmentation_time"].(float64); ok && v != 0.0 {
+		apiObject.SegmentationTime = aws.Float64(v)
+	}
+
+	if v, ok := tfMap["timed_metadata_behavior"].(string); ok && v != "" {
+		apiObject.TimedMetadataBehavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["timed_metadata_pid"].(string); ok && v != "" {
+		apiObject.TimedMetadataPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["transport_stream_id"].(int); ok && v != 0 {
+		apiObject.TransportStreamId = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["video_pid"].(string); ok && v != "" {
+		apiObject.VideoPid = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRawSettings(tfList []interface{}) *medialive.RawSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	return &medialive.RawSettings{}
+}
+
+func expandFrameCaptureOutputSettings(tfList []interface{}) *medialive.FrameCaptureOutputSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.FrameCaptureOutputSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["name_modifier"].(string); ok && v != "" {
+		apiObject.NameModifier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandHlsOutputSettings(tfList []interface{}) *medialive.HlsOutputSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.HlsOutputSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["h265_packaging_type"].(string); ok && v != "" {
+		apiObject.H265PackagingType = aws.String(v)
+	}
+
+	if v, ok := tfMap["hls_settings"].([]interface{}); ok {
+		apiObject.HlsSettings = expandHlsSettings(v)
+	}
+
+	if v, ok := tfMap["name_modifier"].(string); ok && v != "" {
+		apiObject.NameModifier = aws.String(v)
+	}
+
+	if v, ok := tfMap["segment_modifier"].(string); ok && v != "" {
+		apiObject.SegmentModifier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandHlsSettings(tfList []interface{}) *medialive.HlsSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.HlsSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["audio_only_hls_settings"].([]interface{}); ok {
+		apiObject.AudioOnlyHlsSettings = expandAudioOnlyHlsSettings(v)
+	}
+
+	if v, ok := tfMap["fmp4_hls_settings"].([]interface{}); ok {
+		apiObject.Fmp4HlsSettings = expandFmp4HlsSettings(v)
+	}
+
+	if v, ok := tfMap["frame_capture_hls_settings"].([]interface{}); ok {
+		apiObject.FrameCaptureHlsSettings = expandFrameCaptureHlsSettings(v)
+	}
+
+	if v, ok := tfMap["standard_hls_settings"].([]interface{}); ok {
+		apiObject.StandardHlsSettings = expandStandardHlsSettings(v)
+	}
+
+	return apiObject
+}
+
+func expandAudioOnlyHlsSettings(tfList []interface{}) *medialive.AudioOnlyHlsSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.AudioOnlyHlsSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["audio_group_id"].(string); ok && v != "" {
+		apiObject.AudioGroupId = aws.String(v)
+	}
+
+	if v, ok := tfMap["audio_only_image"].([]interface{}); ok {
+		apiObject.AudioOnlyImage = expandInputLocation(v)
+	}
+
+	if v, ok := tfMap["audio_track_type"].(string); ok && v != "" {
+		apiObject.AudioTrackType = aws.String(v)
+	}
+
+	if v, ok := tfMap["segment_type"].(string); ok && v != "" {
+		apiObject.SegmentType = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandInputLocation(tfList []interface{}) *medialive.InputLocation {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.InputLocation{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["password_param"].(string); ok && v != "" {
+		apiObject.PasswordParam = aws.String(v)
+	}
+
+	if v, ok := tfMap["uri"].(string); ok && v != "" {
+		apiObject.Uri = aws.String(v)
+	}
+
+	if v, ok := tfMap["username"].(string); ok && v != "" {
+		apiObject.Username = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandFmp4HlsSettings(tfList []interface{}) *medialive.Fmp4HlsSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.Fmp4HlsSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["audio_rendition_sets"].(string); ok && v != "" {
+		apiObject.AudioRenditionSets = aws.String(v)
+	}
+
+	if v, ok := tfMap["nielsen_id3_behavior"].(string); ok && v != "" {
+		apiObject.NielsenId3Behavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["timed_metadata_behavior"].(string); ok && v != "" {
+		apiObject.TimedMetadataBehavior = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandFrameCaptureHlsSettings(tfList []interface{}) *medialive.FrameCaptureHlsSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	return &medialive.FrameCaptureHlsSettings{}
+}
+
+func expandStandardHlsSettings(tfList []interface{}) *medialive.StandardHlsSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.StandardHlsSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["audio_rendition_sets"].(string); ok && v != "" {
+		apiObject.AudioRenditionSets = aws.String(v)
+	}
+
+	if v, ok := tfMap["m3u8_settings"].([]interface{}); ok {
+		apiObject.M3u8Settings = expandM3u8Settings(v)
+	}
+
+	return apiObject
+}
+
+func expandM3u8Settings(tfList []interface{}) *medialive.M3u8Settings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.M3u8Settings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["audio_frames_per_pes"].(int); ok && v != 0 {
+		apiObject.AudioFramesPerPes = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["audio_pids"].(string); ok && v != "" {
+		apiObject.AudioPids = aws.String(v)
+	}
+
+	if v, ok := tfMap["ecm_pid"].(string); ok && v != "" {
+		apiObject.EcmPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["nielsen_id3_behavior"].(string); ok && v != "" {
+		apiObject.NielsenId3Behavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["pat_interval"].(int); ok && v != 0 {
+		apiObject.PatInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pcr_control"].(string); ok && v != "" {
+		apiObject.PcrControl = aws.String(v)
+	}
+
+	if v, ok := tfMap["pcr_period"].(int); ok && v != 0 {
+		apiObject.PcrPeriod = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pcr_pid"].(string); ok && v != "" {
+		apiObject.PcrPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["pmt_interval"].(int); ok && v != 0 {
+		apiObject.PmtInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["pmt_pid"].(string); ok && v != "" {
+		apiObject.PmtPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["program_num"].(int); ok && v != 0 {
+		apiObject.ProgramNum = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["scte35_behavior"].(string); ok && v != "" {
+		apiObject.Scte35Behavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["scte35_pid"].(string); ok && v != "" {
+		apiObject.Scte35Pid = aws.String(v)
+	}
+
+	if v, ok := tfMap["timed_metadata_behavior"].(string); ok && v != "" {
+		apiObject.TimedMetadataBehavior = aws.String(v)
+	}
+
+	if v, ok := tfMap["timed_metadata_pid"].(string); ok && v != "" {
+		apiObject.TimedMetadataPid = aws.String(v)
+	}
+
+	if v, ok := tfMap["transport_stream_id"].(int); ok && v != 0 {
+		apiObject.TransportStreamId = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["video_pid"].(string); ok && v != "" {
+		apiObject.VideoPid = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandMediaPackageOutputSettings(tfList []interface{}) *medialive.MediaPackageOutputSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	return &medialive.MediaPackageOutputSettings{}
+}
+
+func expandMsSmoothOutputSettings(tfList []interface{}) *medialive.MsSmoothOutputSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.MsSmoothOutputSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["h265_packaging_type"].(string); ok && v != "" {
+		apiObject.H265PackagingType = aws.String(v)
+	}
+
+	if v, ok := tfMap["name_modifier"].(string); ok && v != "" {
+		apiObject.NameModifier = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandRtmpOutputSettings(tfList []interface{}) *medialive.RtmpOutputSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.RtmpOutputSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["certificate_mode"].(string); ok && v != "" {
+		apiObject.CertificateMode = aws.String(v)
+	}
+
+	if v, ok := tfMap["connection_retry_interval"].(int); ok && v != 0 {
+		apiObject.ConnectionRetryInterval = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok {
+		apiObject.Destination = expandOutputLocationRef(v)
+	}
+
+	if v, ok := tfMap["num_retries"].(int); ok && v != 0 {
+		apiObject.NumRetries = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func expandUdpOutputSettings(tfList []interface{}) *medialive.UdpOutputSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.UdpOutputSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["buffer_msec"].(int); ok && v != 0 {
+		apiObject.BufferMsec = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["container_settings"].([]interface{}); ok {
+		apiObject.ContainerSettings = expandUdpContainerSettings(v)
+	}
+
+	if v, ok := tfMap["destination"].([]interface{}); ok {
+		apiObject.Destination = expandOutputLocationRef(v)
+	}
+
+	if v, ok := tfMap["fec_output_settings"].([]interface{}); ok {
+		apiObject.FecOutputSettings = expandFecOutputSettings(v)
+	}
+
+	return apiObject
+}
+
+func expandUdpContainerSettings(tfList []interface{}) *medialive.UdpContainerSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.UdpContainerSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["m2ts_settings"].([]interface{}); ok {
+		apiObject.M2tsSettings = expandM2tsSettings(v)
+	}
+
+	return apiObject
+}
+
+func expandFecOutputSettings(tfList []interface{}) *medialive.FecOutputSettings {
+	if len(tfList) == 0 {
+		return nil
+	}
+
+	apiObject := &medialive.FecOutputSettings{}
+
+	tfMap, ok := tfList[0].(map[string]interface{})
+
+	if !ok {
+		return apiObject
+	}
+
+	if v, ok := tfMap["column_depth"].(int); ok && v != 0 {
+		apiObject.ColumnDepth = aws.Int64(int64(v))
+	}
+
+	if v, ok := tfMap["include_fec"].(string); ok && v != "" {
+		apiObject.IncludeFec = aws.String(v)
+	}
+
+	if v, ok := tfMap["row_length"].(int); ok && v != 0 {
+		apiObject.RowLength = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenOutputGroupSettings(apiObject *medialive.OutputGroupSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"archive_group_settings":       flattenArchiveGroupSettings(apiObject.ArchiveGroupSettings),
+		"frame_capture_group_settings": flattenFrameCaptureGroupSettings(apiObject.FrameCaptureGroupSettings),
+		"hls_group_settings":           flattenHlsGroupSettings(apiObject.HlsGroupSettings),
+		"media_package_group_settings": flattenMediaPackageGroupSettings(apiObject.MediaPackageGroupSettings),
+		"ms_smooth_group_settings":     flattenMsSmoothGroupSettings(apiObject.MsSmoothGroupSettings),
+		"rtmp_group_settings":          flattenRtmpGroupSettings(apiObject.RtmpGroupSettings),
+		"udp_group_settings":           flattenUdpGroupSettings(apiObject.UdpGroupSettings),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenArchiveGroupSettings(apiObject *medialive.ArchiveGroupSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"archive_cdn_settings": flattenArchiveCdnSettings(apiObject.ArchiveCdnSettings),
+		"destination":          flattenOutputLocationRef(apiObject.Destination),
+		"rollover_interval":    aws.Int64Value(apiObject.RolloverInterval),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenArchiveCdnSettings(apiObject *medialive.ArchiveCdnSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"archive_s3_settings": flattenArchiveS3Settings(apiObject.ArchiveS3Settings),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenArchiveS3Settings(apiObject *medialive.ArchiveS3Settings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"canned_acl": aws.StringValue(apiObject.CannedAcl),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFrameCaptureGroupSettings(apiObject *medialive.FrameCaptureGroupSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"destination":                flattenOutputLocationRef(apiObject.Destination),
+		"frame_capture_cdn_settings": flattenFrameCaptureCdnSettings(apiObject.FrameCaptureCdnSettings),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFrameCaptureCdnSettings(apiObject *medialive.FrameCaptureCdnSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"frame_capture_s3_settings": flattenFrameCaptureS3Settings(apiObject.FrameCaptureS3Settings),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFrameCaptureS3Settings(apiObject *medialive.FrameCaptureS3Settings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"canned_acl": aws.StringValue(apiObject.CannedAcl),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenHlsGroupSettings(apiObject *medialive.HlsGroupSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ad_markers":                   aws.StringValueSlice(apiObject.AdMarkers),
+		"base_url_content":             aws.StringValue(apiObject.BaseUrlContent),
+		"base_url_content1":            aws.StringValue(apiObject.BaseUrlContent1),
+		"base_url_manifest":            aws.StringValue(apiObject.BaseUrlManifest),
+		"base_url_manifest1":           aws.StringValue(apiObject.BaseUrlManifest1),
+		"caption_language_mappings":    flattenCaptionLanguageMappings(apiObject.CaptionLanguageMappings),
+		"caption_language_setting":     aws.StringValue(apiObject.CaptionLanguageSetting),
+		"client_cache":                 aws.StringValue(apiObject.ClientCache),
+		"codec_specification":          aws.StringValue(apiObject.CodecSpecification),
+		"constant_iv":                  aws.StringValue(apiObject.ConstantIv),
+		"destination":                  flattenOutputLocationRef(apiObject.Destination),
+		"directory_structure":          aws.StringValue(apiObject.DirectoryStructure),
+		"discontinuity_tags":           aws.StringValue(apiObject.DiscontinuityTags),
+		"encryption_type":              aws.StringValue(apiObject.EncryptionType),
+		"hls_cdn_settings":             flattenHlsCdnSettings(apiObject.HlsCdnSettings),
+		"hls_id3_segment_tagging":      aws.StringValue(apiObject.HlsId3SegmentTagging),
+		"i_frame_only_playlists":       aws.StringValue(apiObject.IFrameOnlyPlaylists),
+		"incomplete_segment_behavior":  aws.StringValue(apiObject.IncompleteSegmentBehavior),
+		"index_nsegments":              aws.Int64Value(apiObject.IndexNSegments),
+		"input_loss_action":            aws.StringValue(apiObject.InputLossAction),
+		"iv_in_manifest":               aws.StringValue(apiObject.IvInManifest),
+		"iv_source":                    aws.StringValue(apiObject.IvSource),
+		"keep_segments":                aws.Int64Value(apiObject.KeepSegments),
+		"key_format":                   aws.StringValue(apiObject.KeyFormat),
+		"key_format_versions":          aws.StringValue(apiObject.KeyFormatVersions),
+		"manifest_compression":         aws.StringValue(apiObject.ManifestCompression),
+		"manifest_duration_format":     aws.StringValue(apiObject.ManifestDurationFormat),
+		"min_segment_length":           aws.Int64Value(apiObject.MinSegmentLength),
+		"mode":                         aws.StringValue(apiObject.Mode),
+		"output_selection":             aws.StringValue(apiObject.OutputSelection),
+		"program_date_time":            aws.StringValue(apiObject.ProgramDateTime),
+		"program_date_time_period":     aws.Int64Value(apiObject.ProgramDateTimePeriod),
+		"redundant_manifest":           aws.StringValue(apiObject.RedundantManifest),
+		"segment_length":               aws.Int64Value(apiObject.SegmentLength),
+		"segmentation_mode":            aws.StringValue(apiObject.SegmentationMode),
+		"segments_per_subdirectory":    aws.Int64Value(apiObject.SegmentsPerSubdirectory),
+		"stream_inf_resolution":        aws.StringValue(apiObject.StreamInfResolution),
+		"timed_metadata_id3_frame":     aws.StringValue(apiObject.TimedMetadataId3Frame),
+		"timed_metadata_id3_period":    aws.Int64Value(apiObject.TimedMetadataId3Period),
+		"timestamp_delta_milliseconds": aws.Int64Value(apiObject.TimestampDeltaMilliseconds),
+		"ts_file_mode":                 aws.StringValue(apiObject.TsFileMode),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenCaptionLanguageMappings(apiObjects []*medialive.CaptionLanguageMapping) []interface{} {
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		if apiObject == nil {
+			continue
+		}
+
+		tfList = append(tfList, map[string]interface{}{
+			"caption_channel":      aws.Int64Value(apiObject.CaptionChannel),
+			"language_code":        aws.StringValue(apiObject.LanguageCode),
+			"language_description": aws.StringValue(apiObject.LanguageDescription),
+		})
+	}
+
+	return tfList
+}
+
+func flattenHlsCdnSettings(apiObject *medialive.HlsCdnSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"hls_akamai_settings":      flattenHlsAkamaiSettings(apiObject.HlsAkamaiSettings),
+		"hls_basic_put_settings":   flattenHlsBasicPutSettings(apiObject.HlsBasicPutSettings),
+		"hls_media_store_settings": flattenHlsMediaStoreSettings(apiObject.HlsMediaStoreSettings),
+		"hls_s3_settings":          flattenHlsS3Settings(apiObject.HlsS3Settings),
+		"hls_webdav_settings":      flattenHlsWebdavSettings(apiObject.HlsWebdavSettings),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenHlsAkamaiSettings(apiObject *medialive.HlsAkamaiSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"connection_retry_interval": aws.Int64Value(apiObject.ConnectionRetryInterval),
+		"filecache_duration":        aws.Int64Value(apiObject.FilecacheDuration),
+		"http_transfer_mode":        aws.StringValue(apiObject.HttpTransferMode),
+		"num_retries":               aws.Int64Value(apiObject.NumRetries),
+		"restart_delay":             aws.Int64Value(apiObject.RestartDelay),
+		"salt":                      aws.StringValue(apiObject.Salt),
+		"token":                     aws.StringValue(apiObject.Token),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenHlsBasicPutSettings(apiObject *medialive.HlsBasicPutSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"connection_retry_interval": aws.Int64Value(apiObject.ConnectionRetryInterval),
+		"filecache_duration":        aws.Int64Value(apiObject.FilecacheDuration),
+		"num_retries":               aws.Int64Value(apiObject.NumRetries),
+		"restart_delay":             aws.Int64Value(apiObject.RestartDelay),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenHlsMediaStoreSettings(apiObject *medialive.HlsMediaStoreSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"connection_retry_interval": aws.Int64Value(apiObject.ConnectionRetryInterval),
+		"filecache_duration":        aws.Int64Value(apiObject.FilecacheDuration),
+		"media_store_storage_class": aws.StringValue(apiObject.MediaStoreStorageClass),
+		"num_retries":               aws.Int64Value(apiObject.NumRetries),
+		"restart_delay":             aws.Int64Value(apiObject.RestartDelay),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenHlsS3Settings(apiObject *medialive.HlsS3Settings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"canned_acl": aws.StringValue(apiObject.CannedAcl),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenHlsWebdavSettings(apiObject *medialive.HlsWebdavSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"connection_retry_interval": aws.Int64Value(apiObject.ConnectionRetryInterval),
+		"filecache_duration":        aws.Int64Value(apiObject.FilecacheDuration),
+		"http_transfer_mode":        aws.StringValue(apiObject.HttpTransferMode),
+		"num_retries":               aws.Int64Value(apiObject.NumRetries),
+		"restart_delay":             aws.Int64Value(apiObject.RestartDelay),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMediaPackageGroupSettings(apiObject *medialive.MediaPackageGroupSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"destination": flattenOutputLocationRef(apiObject.Destination),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMsSmoothGroupSettings(apiObject *medialive.MsSmoothGroupSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"acquisition_point_id":        aws.StringValue(apiObject.AcquisitionPointId),
+		"audio_only_timecode_control": aws.StringValue(apiObject.AudioOnlyTimecodeControl),
+		"certificate_mode":            aws.StringValue(apiObject.CertificateMode),
+		"connection_retry_interval":   aws.Int64Value(apiObject.ConnectionRetryInterval),
+		"destination":                 flattenOutputLocationRef(apiObject.Destination),
+		"event_id":                    aws.StringValue(apiObject.EventId),
+		"event_id_mode":               aws.StringValue(apiObject.EventIdMode),
+		"event_stop_behavior":         aws.StringValue(apiObject.EventStopBehavior),
+		"filecache_duration":          aws.Int64Value(apiObject.FilecacheDuration),
+		"fragment_length":             aws.Int64Value(apiObject.FragmentLength),
+		"input_loss_action":           aws.StringValue(apiObject.InputLossAction),
+		"num_retries":                 aws.Int64Value(apiObject.NumRetries),
+		"restart_delay":               aws.Int64Value(apiObject.RestartDelay),
+		"segmentation_mode":           aws.StringValue(apiObject.SegmentationMode),
+		"send_delay_ms":               aws.Int64Value(apiObject.SendDelayMs),
+		"sparse_track_type":           aws.StringValue(apiObject.SparseTrackType),
+		"stream_manifest_behavior":    aws.StringValue(apiObject.StreamManifestBehavior),
+		"timestamp_offset":            aws.StringValue(apiObject.TimestampOffset),
+		"timestamp_offset_mode":       aws.StringValue(apiObject.TimestampOffsetMode),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRtmpGroupSettings(apiObject *medialive.RtmpGroupSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"ad_markers":            aws.StringValueSlice(apiObject.AdMarkers),
+		"authentication_scheme": aws.StringValue(apiObject.AuthenticationScheme),
+		"cache_full_behavior":   aws.StringValue(apiObject.CacheFullBehavior),
+		"cache_length":          aws.Int64Value(apiObject.CacheLength),
+		"caption_data":          aws.StringValue(apiObject.CaptionData),
+		"input_loss_action":     aws.StringValue(apiObject.InputLossAction),
+		"restart_delay":         aws.Int64Value(apiObject.RestartDelay),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenUdpGroupSettings(apiObject *medialive.UdpGroupSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"input_loss_action":         aws.StringValue(apiObject.InputLossAction),
+		"timed_metadata_id3_frame":  aws.StringValue(apiObject.TimedMetadataId3Frame),
+		"timed_metadata_id3_period": aws.Int64Value(apiObject.TimedMetadataId3Period),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenOutputSettings(apiObject *medialive.OutputSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"archive_output_settings":       flattenArchiveOutputSettings(apiObject.ArchiveOutputSettings),
+		"frame_capture_output_settings": flattenFrameCaptureOutputSettings(apiObject.FrameCaptureOutputSettings),
+		"hls_output_settings":           flattenHlsOutputSettings(apiObject.HlsOutputSettings),
+		"media_package_output_settings": flattenMediaPackageOutputSettings(apiObject.MediaPackageOutputSettings),
+		"ms_smooth_output_settings":     flattenMsSmoothOutputSettings(apiObject.MsSmoothOutputSettings),
+		"rtmp_output_settings":          flattenRtmpOutputSettings(apiObject.RtmpOutputSettings),
+		"udp_output_settings":           flattenUdpOutputSettings(apiObject.UdpOutputSettings),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenArchiveOutputSettings(apiObject *medialive.ArchiveOutputSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"container_settings": flattenArchiveContainerSettings(apiObject.ContainerSettings),
+		"extension":          aws.StringValue(apiObject.Extension),
+		"name_modifier":      aws.StringValue(apiObject.NameModifier),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenArchiveContainerSettings(apiObject *medialive.ArchiveContainerSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"m2ts_settings": flattenM2tsSettings(apiObject.M2tsSettings),
+		"raw_settings":  flattenRawSettings(apiObject.RawSettings),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenM2tsSettings(apiObject *medialive.M2tsSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"absent_input_audio_behavior": aws.StringValue(apiObject.AbsentInputAudioBehavior),
+		"arib":                        aws.StringValue(apiObject.Arib),
+		"arib_captions_pid":           aws.StringValue(apiObject.AribCaptionsPid),
+		"arib_captions_pid_control":   aws.StringValue(apiObject.AribCaptionsPidControl),
+		"audio_buffer_model":          aws.StringValue(apiObject.AudioBufferModel),
+		"audio_frames_per_pes":        aws.Int64Value(apiObject.AudioFramesPerPes),
+		"audio_pids":                  aws.StringValue(apiObject.AudioPids),
+		"audio_stream_type":           aws.StringValue(apiObject.AudioStreamType),
+		"bitrate":                     aws.Int64Value(apiObject.Bitrate),
+		"buffer_model":                aws.StringValue(apiObject.BufferModel),
+		"cc_descriptor":               aws.StringValue(apiObject.CcDescriptor),
+		"dvb_sub_pids":                aws.StringValue(apiObject.DvbSubPids),
+		"dvb_teletext_pid":            aws.StringValue(apiObject.DvbTeletextPid),
+		"ebif":                        aws.StringValue(apiObject.Ebif),
+		"ebp_audio_interval":          aws.StringValue(apiObject.EbpAudioInterval),
+		"ebp_lookahead_ms":            aws.Int64Value(apiObject.EbpLookaheadMs),
+		"ebp_placement":               aws.StringValue(apiObject.EbpPlacement),
+		"ecm_pid":                     aws.StringValue(apiObject.EcmPid),
+		"es_rate_in_pes":              aws.StringValue(apiObject.EsRateInPes),
+		"etv_platform_pid":            aws.StringValue(apiObject.EtvPlatformPid),
+		"etv_signal_pid":              aws.StringValue(apiObject.EtvSignalPid),
+		"fragment_time":               aws.Float64Value(apiObject.FragmentTime),
+		"klv":                         aws.StringValue(apiObject.Klv),
+		"klv_data_pids":               aws.StringValue(apiObject.KlvDataPids),
+		"nielsen_id3_behavior":        aws.StringValue(apiObject.NielsenId3Behavior),
+		"null_packet_bitrate":         aws.Float64Value(apiObject.NullPacketBitrate),
+		"pat_interval":                aws.Int64Value(apiObject.PatInterval),
+		"pcr_control":                 aws.StringValue(apiObject.PcrControl),
+		"pcr_period":                  aws.Int64Value(apiObject.PcrPeriod),
+		"pcr_pid":                     aws.StringValue(apiObject.PcrPid),
+		"pmt_interval":                aws.Int64Value(apiObject.PmtInterval),
+		"pmt_pid":                     aws.StringValue(apiObject.PmtPid),
+		"program_num":                 aws.Int64Value(apiObject.ProgramNum),
+		"rate_mode":                   aws.StringValue(apiObject.RateMode),
+		"scte27_pids":                 aws.StringValue(apiObject.Scte27Pids),
+		"scte35_control":              aws.StringValue(apiObject.Scte35Control),
+		"scte35_pid":                  aws.StringValue(apiObject.Scte35Pid),
+		"segmentation_markers":        aws.StringValue(apiObject.SegmentationMarkers),
+		"segmentation_style":          aws.StringValue(apiObject.SegmentationStyle),
+		"segmentation_time":           aws.Float64Value(apiObject.SegmentationTime),
+		"timed_metadata_behavior":     aws.StringValue(apiObject.TimedMetadataBehavior),
+		"timed_metadata_pid":          aws.StringValue(apiObject.TimedMetadataPid),
+		"transport_stream_id":         aws.Int64Value(apiObject.TransportStreamId),
+		"video_pid":                   aws.StringValue(apiObject.VideoPid),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRawSettings(apiObject *medialive.RawSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{}}
+}
+
+func flattenFrameCaptureOutputSettings(apiObject *medialive.FrameCaptureOutputSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"name_modifier": aws.StringValue(apiObject.NameModifier),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenHlsOutputSettings(apiObject *medialive.HlsOutputSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"h265_packaging_type": aws.StringValue(apiObject.H265PackagingType),
+		"hls_settings":        flattenHlsSettings(apiObject.HlsSettings),
+		"name_modifier":       aws.StringValue(apiObject.NameModifier),
+		"segment_modifier":    aws.StringValue(apiObject.SegmentModifier),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenHlsSettings(apiObject *medialive.HlsSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"audio_only_hls_settings":    flattenAudioOnlyHlsSettings(apiObject.AudioOnlyHlsSettings),
+		"fmp4_hls_settings":          flattenFmp4HlsSettings(apiObject.Fmp4HlsSettings),
+		"frame_capture_hls_settings": flattenFrameCaptureHlsSettings(apiObject.FrameCaptureHlsSettings),
+		"standard_hls_settings":      flattenStandardHlsSettings(apiObject.StandardHlsSettings),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenAudioOnlyHlsSettings(apiObject *medialive.AudioOnlyHlsSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"audio_group_id":   aws.StringValue(apiObject.AudioGroupId),
+		"audio_only_image": flattenInputLocation(apiObject.AudioOnlyImage),
+		"audio_track_type": aws.StringValue(apiObject.AudioTrackType),
+		"segment_type":     aws.StringValue(apiObject.SegmentType),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenInputLocation(apiObject *medialive.InputLocation) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"password_param": aws.StringValue(apiObject.PasswordParam),
+		"uri":            aws.StringValue(apiObject.Uri),
+		"username":       aws.StringValue(apiObject.Username),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFmp4HlsSettings(apiObject *medialive.Fmp4HlsSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"audio_rendition_sets":    aws.StringValue(apiObject.AudioRenditionSets),
+		"nielsen_id3_behavior":    aws.StringValue(apiObject.NielsenId3Behavior),
+		"timed_metadata_behavior": aws.StringValue(apiObject.TimedMetadataBehavior),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFrameCaptureHlsSettings(apiObject *medialive.FrameCaptureHlsSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{}}
+}
+
+func flattenStandardHlsSettings(apiObject *medialive.StandardHlsSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"audio_rendition_sets": aws.StringValue(apiObject.AudioRenditionSets),
+		"m3u8_settings":        flattenM3u8Settings(apiObject.M3u8Settings),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenM3u8Settings(apiObject *medialive.M3u8Settings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"audio_frames_per_pes":    aws.Int64Value(apiObject.AudioFramesPerPes),
+		"audio_pids":              aws.StringValue(apiObject.AudioPids),
+		"ecm_pid":                 aws.StringValue(apiObject.EcmPid),
+		"nielsen_id3_behavior":    aws.StringValue(apiObject.NielsenId3Behavior),
+		"pat_interval":            aws.Int64Value(apiObject.PatInterval),
+		"pcr_control":             aws.StringValue(apiObject.PcrControl),
+		"pcr_period":              aws.Int64Value(apiObject.PcrPeriod),
+		"pcr_pid":                 aws.StringValue(apiObject.PcrPid),
+		"pmt_interval":            aws.Int64Value(apiObject.PmtInterval),
+		"pmt_pid":                 aws.StringValue(apiObject.PmtPid),
+		"program_num":             aws.Int64Value(apiObject.ProgramNum),
+		"scte35_behavior":         aws.StringValue(apiObject.Scte35Behavior),
+		"scte35_pid":              aws.StringValue(apiObject.Scte35Pid),
+		"timed_metadata_behavior": aws.StringValue(apiObject.TimedMetadataBehavior),
+		"timed_metadata_pid":      aws.StringValue(apiObject.TimedMetadataPid),
+		"transport_stream_id":     aws.Int64Value(apiObject.TransportStreamId),
+		"video_pid":               aws.StringValue(apiObject.VideoPid),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenMediaPackageOutputSettings(apiObject *medialive.MediaPackageOutputSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{}}
+}
+
+func flattenMsSmoothOutputSettings(apiObject *medialive.MsSmoothOutputSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"h265_packaging_type": aws.StringValue(apiObject.H265PackagingType),
+		"name_modifier":       aws.StringValue(apiObject.NameModifier),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenRtmpOutputSettings(apiObject *medialive.RtmpOutputSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"certificate_mode":          aws.StringValue(apiObject.CertificateMode),
+		"connection_retry_interval": aws.Int64Value(apiObject.ConnectionRetryInterval),
+		"destination":               flattenOutputLocationRef(apiObject.Destination),
+		"num_retries":               aws.Int64Value(apiObject.NumRetries),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenUdpOutputSettings(apiObject *medialive.UdpOutputSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"buffer_msec":         aws.Int64Value(apiObject.BufferMsec),
+		"container_settings":  flattenUdpContainerSettings(apiObject.ContainerSettings),
+		"destination":         flattenOutputLocationRef(apiObject.Destination),
+		"fec_output_settings": flattenFecOutputSettings(apiObject.FecOutputSettings),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenUdpContainerSettings(apiObject *medialive.UdpContainerSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"m2ts_settings": flattenM2tsSettings(apiObject.M2tsSettings),
+	}
+
+	return []interface{}{tfMap}
+}
+
+func flattenFecOutputSettings(apiObject *medialive.FecOutputSettings) []interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{
+		"column_depth": aws.Int64Value(apiObject.ColumnDepth),
+		"include_fec":  aws.StringValue(apiObject.IncludeFec),
+		"row_length":   aws.Int64Value(apiObject.RowLength),
+	}
+
+	return []interface{}{tfMap}
+}
@@ -0,0 +1,339 @@
+package medialive_test
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/medialive"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmedialive "github.com/hashicorp/terraform-provider-aws/internal/service/medialive"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// MediaLive inputs can only be attached to a single channel so these tests
+// are run serially against an existing input.
+func testAccPreCheckInput(t *testing.T) string {
+	inputID := os.Getenv("AWS_MEDIALIVE_INPUT_ID")
+
+	if inputID == "" {
+		t.Skip("Environment variable AWS_MEDIALIVE_INPUT_ID is not set")
+	}
+
+	return inputID
+}
+
+func TestAccMediaLiveChannel_basic(t *testing.T) {
+	var v medialive.DescribeChannelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_channel.test"
+	inputID := testAccPreCheckInput(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig(rName, inputID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, "arn", "medialive", regexp.MustCompile(`channel:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "channel_class", medialive.ChannelClassSinglePipeline),
+					resource.TestCheckResourceAttrSet(resourceName, "channel_id"),
+					resource.TestCheckResourceAttr(resourceName, "destinations.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.output_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "encoder_settings.0.timecode_config.0.source", medialive.TimecodeConfigSourceEmbedded),
+					resource.TestCheckResourceAttr(resourceName, "input_attachments.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "input_attachments.0.input_id", inputID),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.0.codec", medialive.InputCodecAvc),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.0.input_resolution", medialive.InputResolutionHd),
+					resource.TestCheckResourceAttr(resourceName, "input_specification.0.maximum_bitrate", medialive.InputMaximumBitrateMax20Mbps),
+					resource.TestCheckResourceAttr(resourceName, "name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveChannel_disappears(t *testing.T) {
+	var v medialive.DescribeChannelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_channel.test"
+	inputID := testAccPreCheckInput(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig(rName, inputID),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					acctest.CheckResourceDisappears(acctest.Provider, tfmedialive.ResourceChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMediaLiveChannel_tags(t *testing.T) {
+	var v medialive.DescribeChannelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_medialive_channel.test"
+	inputID := testAccPreCheckInput(t)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); acctest.PreCheckPartitionHasService(medialive.EndpointsID, t) },
+		ErrorCheck:   acctest.ErrorCheck(t, medialive.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckChannelDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfigTags1(rName, inputID, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccChannelConfigTags2(rName, inputID, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccChannelConfigTags1(rName, inputID, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckChannelExists(n string, v *medialive.DescribeChannelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No MediaLive Channel ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+
+		output, err := tfmedialive.FindChannelByID(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckChannelDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).MediaLiveConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_medialive_channel" {
+			continue
+		}
+
+		_, err := tfmedialive.FindChannelByID(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("MediaLive Channel %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccChannelConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = <<EOF
+{
+  "Version": "2012-10-17",
+  "Statement": [{
+    "Effect": "Allow",
+    "Principal": {
+      "Service": "medialive.${data.aws_partition.current.dns_suffix}"
+    },
+    "Action": "sts:AssumeRole"
+  }]
+}
+EOF
+}
+
+resource "aws_media_package_channel" "test" {
+  channel_id = %[1]q
+}
+`, rName)
+}
+
+func testAccChannelConfigEncoderSettings() string {
+	return `
+  destinations {
+    id = "destination"
+
+    media_package_settings {
+      channel_id = aws_media_package_channel.test.channel_id
+    }
+  }
+
+  encoder_settings {
+    timecode_config {
+      source = "EMBEDDED"
+    }
+
+    audio_descriptions {
+      audio_selector_name = "default"
+      name                = "audio"
+    }
+
+    video_descriptions {
+      name   = "video"
+      height = 720
+      width  = 1280
+    }
+
+    output_groups {
+      output_group_settings {
+        media_package_group_settings {
+          destination {
+            destination_ref_id = "destination"
+          }
+        }
+      }
+
+      outputs {
+        output_name             = "output"
+        audio_description_names = ["audio"]
+        video_description_name  = "video"
+
+        output_settings {
+          media_package_output_settings {}
+        }
+      }
+    }
+  }
+`
+}
+
+func testAccChannelConfig(rName, inputID string) string {
+	return acctest.ConfigCompose(testAccChannelConfigBase(rName), fmt.Sprintf(`
+resource "aws_medialive_channel" "test" {
+  name          = %[1]q
+  channel_class = "SINGLE_PIPELINE"
+  role_arn      = aws_iam_role.test.arn
+
+  input_specification {
+    codec            = "AVC"
+    input_resolution = "HD"
+    maximum_bitrate  = "MAX_20_MBPS"
+  }
+
+  input_attachments {
+    input_attachment_name = "input"
+    input_id              = %[2]q
+  }
+%[3]s
+}
+`, rName, inputID, testAccChannelConfigEncoderSettings()))
+}
+
+func testAccChannelConfigTags1(rName, inputID, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccChannelConfigBase(rName), fmt.Sprintf(`
+resource "aws_medialive_channel" "test" {
+  name          = %[1]q
+  channel_class = "SINGLE_PIPELINE"
+  role_arn      = aws_iam_role.test.arn
+
+  input_specification {
+    codec            = "AVC"
+    input_resolution = "HD"
+    maximum_bitrate  = "MAX_20_MBPS"
+  }
+
+  input_attachments {
+    input_attachment_name = "input"
+    input_id              = %[2]q
+  }
+%[3]s
+  tags = {
+    %[4]q = %[5]q
+  }
+}
+`, rName, inputID, testAccChannelConfigEncoderSettings(), tagKey1, tagValue1))
+}
+
+func testAccChannelConfigTags2(rName, inputID, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccChannelConfigBase(rName), fmt.Sprintf(`
+resource "aws_medialive_channel" "test" {
+  name          = %[1]q
+  channel_class = "SINGLE_PIPELINE"
+  role_arn      = aws_iam_role.test.arn
+
+  input_specification {
+    codec            = "AVC"
+    input_resolution = "HD"
+    maximum_bitrate  = "MAX_20_MBPS"
+  }
+
+  input_attachments {
+    input_attachment_name = "input"
+    input_id              = %[2]q
+  }
+%[3]s
+  tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+}
+`, rName, inputID, testAccChannelConfigEncoderSettings(), tagKey1, tagValue1, tagKey2, tagValue2))
+}
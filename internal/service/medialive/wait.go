@@ -0,0 +1,65 @@
+package medialive
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/medialive"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+const (
+	channelCreatedTimeout = 15 * time.Minute
+	channelDeletedTimeout = 15 * time.Minute
+	channelUpdatedTimeout = 15 * time.Minute
+)
+
+func waitChannelCreated(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateCreating},
+		Target:  []string{medialive.ChannelStateIdle},
+		Refresh: statusChannelState(conn, id),
+		Timeout: channelCreatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitChannelUpdated(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateUpdating},
+		Target:  []string{medialive.ChannelStateIdle},
+		Refresh: statusChannelState(conn, id),
+		Timeout: channelUpdatedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitChannelDeleted(conn *medialive.MediaLive, id string) (*medialive.DescribeChannelOutput, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{medialive.ChannelStateDeleting},
+		Target:  []string{},
+		Refresh: statusChannelState(conn, id),
+		Timeout: channelDeletedTimeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*medialive.DescribeChannelOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
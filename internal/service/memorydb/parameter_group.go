@@ -5,7 +5,9 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"math"
 	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -300,15 +302,30 @@ func modifyParameterGroupParameters(ctx context.Context, conn *memorydb.MemoryDB
 		ParameterGroupName:  aws.String(name),
 		ParameterNameValues: parameters,
 	}
-	_, err := conn.UpdateParameterGroupWithContext(ctx, &input)
-	return err
+
+	return resource.Retry(30*time.Second, func() *resource.RetryError {
+		_, err := conn.UpdateParameterGroupWithContext(ctx, &input)
+		if err != nil {
+			if tfawserr.ErrMessageContains(err, memorydb.ErrCodeInvalidParameterGroupStateFault, " has pending changes") {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
 }
 
+// parameterGroupParametersQueryFunc returns the parameters in the MemoryDB
+// parameter group with the given name.
+type parameterGroupParametersQueryFunc func(ctx context.Context, parameterGroupName string) ([]*memorydb.Parameter, error)
+
 // listParameterGroupParameters returns the user-defined MemoryDB parameters
 // in the group with the given name and family.
 //
 // Parameters given in userDefined will be returned even if the value is equal
-// to the default.
+// to the default. Callers pass the parameters currently held in state, so that
+// a parameter reset to its default outside of Terraform is still read back and
+// shows up as a difference on the next plan.
 func listParameterGroupParameters(ctx context.Context, conn *memorydb.MemoryDB, family, name string, userDefined map[string]string) ([]*memorydb.Parameter, error) {
 	query := func(ctx context.Context, parameterGroupName string) ([]*memorydb.Parameter, error) {
 		input := memorydb.DescribeParametersInput{
@@ -323,13 +340,26 @@ func listParameterGroupParameters(ctx context.Context, conn *memorydb.MemoryDB,
 		return output.Parameters, nil
 	}
 
+	return listParameterGroupParametersWithQuery(ctx, query, family, name, userDefined)
+}
+
+// listParameterGroupParametersWithQuery implements listParameterGroupParameters
+// on top of the given query function.
+func listParameterGroupParametersWithQuery(ctx context.Context, query parameterGroupParametersQueryFunc, family, name string, userDefined map[string]string) ([]*memorydb.Parameter, error) {
 	// There isn't an official API for defaults, and the mapping of family
 	// to default parameter group name is a guess.
 
 	defaultsFamily := "default." + strings.ReplaceAll(family, "_", "-")
 
 	defaults, err := query(ctx, defaultsFamily)
-	if err != nil {
+
+	if tfawserr.ErrCodeEquals(err, memorydb.ErrCodeParameterGroupNotFoundFault) {
+		// New engine families may not have a default parameter group under
+		// the guessed name yet. Treat every default value as empty rather
+		// than failing the read.
+		log.Printf("[WARN] MemoryDB default Parameter Group (%s) not found, treating all default values as empty", defaultsFamily)
+		defaults = nil
+	} else if err != nil {
 		return nil, fmt.Errorf("list defaults for family %s: %w", defaultsFamily, err)
 	}
 
@@ -349,9 +379,18 @@ func listParameterGroupParameters(ctx context.Context, conn *memorydb.MemoryDB,
 		name := aws.StringValue(parameter.Name)
 		currentValue := aws.StringValue(parameter.Value)
 		defaultValue := defaultValueByName[name]
-		_, isUserDefined := userDefined[name]
+		userValue, isUserDefined := userDefined[name]
 
 		if currentValue != defaultValue || isUserDefined {
+			// MemoryDB may report a numeric value in a different format than
+			// was configured (e.g. "1.0" for "1"). Keep the configured value
+			// so that the parameter set does not show a spurious diff.
+			if isUserDefined && userValue != currentValue && parameterValuesEqual(userValue, currentValue) {
+				p := *parameter
+				p.Value = aws.String(userValue)
+				parameter = &p
+			}
+
 			result = append(result, parameter)
 		}
 	}
@@ -404,7 +443,7 @@ func ParameterChanges(o, n interface{}) (remove, addOrUpdate []*memorydb.Paramet
 	addOrUpdate = make([]*memorydb.ParameterNameValue, 0, ns.Len())
 	for k, nv := range nm {
 		ov, ok := om[k]
-		if !ok || ok && (aws.StringValue(nv.ParameterValue) != aws.StringValue(ov.ParameterValue)) {
+		if !ok || ok && !parameterValuesEqual(aws.StringValue(nv.ParameterValue), aws.StringValue(ov.ParameterValue)) {
 			addOrUpdate = append(addOrUpdate, nm[k])
 		}
 	}
@@ -412,6 +451,27 @@ func ParameterChanges(o, n interface{}) (remove, addOrUpdate []*memorydb.Paramet
 	return remove, addOrUpdate
 }
 
+// parameterValuesEqual reports whether two parameter values are equal.
+// Values that both parse as numbers are compared numerically, so that e.g.
+// "1" and "1.0" are considered equal. All other values are compared as strings.
+func parameterValuesEqual(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	af, err := strconv.ParseFloat(a, 64)
+	if err != nil || math.IsNaN(af) {
+		return false
+	}
+
+	bf, err := strconv.ParseFloat(b, 64)
+	if err != nil || math.IsNaN(bf) {
+		return false
+	}
+
+	return af == bf
+}
+
 func flattenParameters(list []*memorydb.Parameter) []map[string]interface{} {
 	result := make([]map[string]interface{}, 0, len(list))
 	for _, i := range list {
@@ -446,8 +506,10 @@ func createUserDefinedParameterMap(d *schema.ResourceData) map[string]string {
 			continue
 		}
 
+		// An empty value is still tracked in state, so it must be read back
+		// even when it matches the (empty) default.
 		value, ok := m["value"].(string)
-		if !ok || value == "" {
+		if !ok {
 			continue
 		}
 
@@ -0,0 +1,102 @@
+package memorydb_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccMemoryDBParameterGroupDataSource_basic(t *testing.T) {
+	rName := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_memorydb_parameter_group.test"
+	dataSourceName := "data.aws_memorydb_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, memorydb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupDataSourceConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "arn", resourceName, "arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "description", resourceName, "description"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "family", resourceName, "family"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "name", resourceName, "name"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameter.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "parameter.*", map[string]string{
+						"name":  "active-defrag-cycle-max",
+						"value": "70",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs(dataSourceName, "parameter.*", map[string]string{
+						"name":  "active-defrag-cycle-min",
+						"value": "10",
+					}),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "tags.Test", "test"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBParameterGroupDataSource_default(t *testing.T) {
+	dataSourceName := "data.aws_memorydb_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, memorydb.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupDataSourceConfig_default(),
+				Check: resource.ComposeTestCheckFunc(
+					acctest.CheckResourceAttrRegionalARN(dataSourceName, "arn", "memorydb", "parametergroup/default.memorydb-redis6"),
+					resource.TestCheckResourceAttr(dataSourceName, "family", "memorydb_redis6"),
+					resource.TestCheckResourceAttr(dataSourceName, "name", "default.memorydb-redis6"),
+					resource.TestCheckResourceAttr(dataSourceName, "parameter.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccParameterGroupDataSourceConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_memorydb_parameter_group" "test" {
+  name   = %[1]q
+  family = "memorydb_redis6"
+
+  parameter {
+    name  = "active-defrag-cycle-max"
+    value = "70"
+  }
+
+  parameter {
+    name  = "active-defrag-cycle-min"
+    value = "10"
+  }
+
+  tags = {
+    Test = "test"
+  }
+}
+
+data "aws_memorydb_parameter_group" "test" {
+  name = aws_memorydb_parameter_group.test.name
+}
+`, rName)
+}
+
+func testAccParameterGroupDataSourceConfig_default() string {
+	return `
+data "aws_memorydb_parameter_group" "test" {
+  name = "default.memorydb-redis6"
+}
+`
+}
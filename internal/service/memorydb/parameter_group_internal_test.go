@@ -0,0 +1,205 @@
+package memorydb
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Test_listParameterGroupParametersWithQuery(t *testing.T) {
+	current := []*memorydb.Parameter{
+		{Name: aws.String("activedefrag"), Value: aws.String("no")},
+		{Name: aws.String("maxmemory-policy"), Value: aws.String("allkeys-lru")},
+		{Name: aws.String("timeout"), Value: aws.String("0")},
+		{Name: aws.String("unset"), Value: aws.String("")},
+	}
+
+	cases := []struct {
+		Name        string
+		Defaults    []*memorydb.Parameter
+		DefaultsErr error
+		UserDefined map[string]string
+		Expected    []*memorydb.Parameter
+		ExpectedErr bool
+	}{
+		{
+			Name: "Defaults omitted",
+			Defaults: []*memorydb.Parameter{
+				{Name: aws.String("activedefrag"), Value: aws.String("no")},
+				{Name: aws.String("maxmemory-policy"), Value: aws.String("noeviction")},
+				{Name: aws.String("timeout"), Value: aws.String("0")},
+				{Name: aws.String("unset"), Value: aws.String("")},
+			},
+			UserDefined: map[string]string{},
+			Expected: []*memorydb.Parameter{
+				{Name: aws.String("maxmemory-policy"), Value: aws.String("allkeys-lru")},
+			},
+		},
+		{
+			Name: "User-defined defaults kept",
+			Defaults: []*memorydb.Parameter{
+				{Name: aws.String("activedefrag"), Value: aws.String("no")},
+				{Name: aws.String("maxmemory-policy"), Value: aws.String("noeviction")},
+				{Name: aws.String("timeout"), Value: aws.String("0")},
+				{Name: aws.String("unset"), Value: aws.String("")},
+			},
+			UserDefined: map[string]string{"timeout": "0"},
+			Expected: []*memorydb.Parameter{
+				{Name: aws.String("maxmemory-policy"), Value: aws.String("allkeys-lru")},
+				{Name: aws.String("timeout"), Value: aws.String("0")},
+			},
+		},
+		{
+			Name: "Numerically equal user-defined value kept",
+			Defaults: []*memorydb.Parameter{
+				{Name: aws.String("activedefrag"), Value: aws.String("no")},
+				{Name: aws.String("maxmemory-policy"), Value: aws.String("noeviction")},
+				{Name: aws.String("timeout"), Value: aws.String("300")},
+				{Name: aws.String("unset"), Value: aws.String("")},
+			},
+			UserDefined: map[string]string{"timeout": "0.0"},
+			Expected: []*memorydb.Parameter{
+				{Name: aws.String("maxmemory-policy"), Value: aws.String("allkeys-lru")},
+				{Name: aws.String("timeout"), Value: aws.String("0.0")},
+			},
+		},
+		{
+			Name:        "Default parameter group not found",
+			DefaultsErr: awserr.New(memorydb.ErrCodeParameterGroupNotFoundFault, "ParameterGroup not found", nil),
+			UserDefined: map[string]string{},
+			Expected: []*memorydb.Parameter{
+				{Name: aws.String("activedefrag"), Value: aws.String("no")},
+				{Name: aws.String("maxmemory-policy"), Value: aws.String("allkeys-lru")},
+				{Name: aws.String("timeout"), Value: aws.String("0")},
+			},
+		},
+		{
+			Name:        "Default parameter group error",
+			DefaultsErr: awserr.New(memorydb.ErrCodeInvalidParameterValueException, "invalid", nil),
+			UserDefined: map[string]string{},
+			ExpectedErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		query := func(ctx context.Context, parameterGroupName string) ([]*memorydb.Parameter, error) {
+			switch parameterGroupName {
+			case "default.memorydb-redis6":
+				return tc.Defaults, tc.DefaultsErr
+			case "test":
+				return current, nil
+			}
+
+			t.Fatalf("Case %q: unexpected parameter group name %q", tc.Name, parameterGroupName)
+			return nil, nil
+		}
+
+		got, err := listParameterGroupParametersWithQuery(context.Background(), query, "memorydb_redis6", "test", tc.UserDefined)
+
+		if tc.ExpectedErr {
+			if err == nil {
+				t.Errorf("Case %q: expected error, got none", tc.Name)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Case %q: unexpected error: %s", tc.Name, err)
+			continue
+		}
+
+		if !reflect.DeepEqual(got, tc.Expected) {
+			t.Errorf("Case %q: parameters did not match\n%#v\n\nGot:\n%#v", tc.Name, tc.Expected, got)
+		}
+	}
+}
+
+func Test_parameterValuesEqual(t *testing.T) {
+	cases := []struct {
+		A        string
+		B        string
+		Expected bool
+	}{
+		{A: "", B: "", Expected: true},
+		{A: "yes", B: "yes", Expected: true},
+		{A: "yes", B: "no", Expected: false},
+		{A: "1", B: "1", Expected: true},
+		{A: "1", B: "1.0", Expected: true},
+		{A: "1.50", B: "1.5", Expected: true},
+		{A: "0", B: "-0", Expected: true},
+		{A: "1000", B: "1e3", Expected: true},
+		{A: "1", B: "2", Expected: false},
+		{A: "1", B: "1.01", Expected: false},
+		{A: "1", B: "", Expected: false},
+		{A: "1", B: "yes", Expected: false},
+		{A: "6.2.6", B: "6.2", Expected: false},
+		{A: "6.2.6", B: "6.2.6", Expected: true},
+		{A: "NaN", B: "nan", Expected: false},
+	}
+
+	for _, tc := range cases {
+		if got := parameterValuesEqual(tc.A, tc.B); got != tc.Expected {
+			t.Errorf("parameterValuesEqual(%q, %q) = %t, want %t", tc.A, tc.B, got, tc.Expected)
+		}
+		if got := parameterValuesEqual(tc.B, tc.A); got != tc.Expected {
+			t.Errorf("parameterValuesEqual(%q, %q) = %t, want %t", tc.B, tc.A, got, tc.Expected)
+		}
+	}
+}
+
+func Test_listParameterGroupParametersWithQuery_noDiff(t *testing.T) {
+	query := func(ctx context.Context, parameterGroupName string) ([]*memorydb.Parameter, error) {
+		switch parameterGroupName {
+		case "default.memorydb-redis6":
+			return []*memorydb.Parameter{
+				{Name: aws.String("activedefrag"), Value: aws.String("no")},
+				{Name: aws.String("timeout"), Value: aws.String("0")},
+			}, nil
+		case "test":
+			return []*memorydb.Parameter{
+				{Name: aws.String("activedefrag"), Value: aws.String("yes")},
+				{Name: aws.String("timeout"), Value: aws.String("1.0")},
+			}, nil
+		}
+
+		t.Fatalf("unexpected parameter group name %q", parameterGroupName)
+		return nil, nil
+	}
+
+	configured := schema.NewSet(ParameterHash, []interface{}{
+		map[string]interface{}{"name": "activedefrag", "value": "yes"},
+		map[string]interface{}{"name": "timeout", "value": "1"},
+	})
+
+	userDefined := map[string]string{}
+	for _, raw := range configured.List() {
+		m := raw.(map[string]interface{})
+		userDefined[m["name"].(string)] = m["value"].(string)
+	}
+
+	parameters, err := listParameterGroupParametersWithQuery(context.Background(), query, "memorydb_redis6", "test", userDefined)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	read := schema.NewSet(ParameterHash, nil)
+	for _, v := range flattenParameters(parameters) {
+		read.Add(v)
+	}
+
+	if !read.Equal(configured) {
+		t.Errorf("read parameters do not match configuration\n%#v\n\nGot:\n%#v", configured.List(), read.List())
+	}
+
+	remove, addOrUpdate := ParameterChanges(read, configured)
+
+	if len(remove) != 0 || len(addOrUpdate) != 0 {
+		t.Errorf("expected no parameter changes, got remove: %s, addOrUpdate: %s", remove, addOrUpdate)
+	}
+}
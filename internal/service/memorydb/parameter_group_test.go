@@ -190,6 +190,78 @@ func TestAccMemoryDBParameterGroup_update_parameters(t *testing.T) {
 	})
 }
 
+func TestAccMemoryDBParameterGroup_update_parametersImmediately(t *testing.T) {
+	rName := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	// Updating straight after creation exercises both the reset and the
+	// modify paths while the group may still have pending changes.
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, memorydb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupConfig_withParameter2(rName, "timeout", "20", "activerehashing", "no"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "2"),
+				),
+			},
+			{
+				Config: testAccParameterGroupConfig_withParameter1(rName, "timeout", "30"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "timeout",
+						"value": "30",
+					}),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMemoryDBParameterGroup_update_parametersResetOutOfBand(t *testing.T) {
+	rName := "tf-test-" + sdkacctest.RandString(8)
+	resourceName := "aws_memorydb_parameter_group.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t); testAccPreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, memorydb.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterGroupConfig_withParameter1(rName, "timeout", "20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName),
+					testAccCheckParameterGroupResetParameter(resourceName, "timeout"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccParameterGroupConfig_withParameter1(rName, "timeout", "20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterGroupExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "parameter.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "parameter.*", map[string]string{
+						"name":  "timeout",
+						"value": "20",
+					}),
+				),
+			},
+		},
+	})
+}
+
 func TestAccMemoryDBParameterGroup_update_tags(t *testing.T) {
 	rName := "tf-test-" + sdkacctest.RandString(8)
 	resourceName := "aws_memorydb_parameter_group.test"
@@ -309,6 +381,26 @@ func testAccCheckParameterGroupExists(n string) resource.TestCheckFunc {
 	}
 }
 
+// testAccCheckParameterGroupResetParameter resets the named parameter to its
+// default value outside of Terraform.
+func testAccCheckParameterGroupResetParameter(n, parameterName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MemoryDBConn
+
+		_, err := conn.ResetParameterGroupWithContext(context.Background(), &memorydb.ResetParameterGroupInput{
+			ParameterGroupName: aws.String(rs.Primary.Attributes["name"]),
+			ParameterNames:     aws.StringSlice([]string{parameterName}),
+		})
+
+		return err
+	}
+}
+
 func testAccParameterGroupConfig(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_memorydb_parameter_group" "test" {
@@ -512,6 +604,45 @@ func TestParameterChanges(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "Numerically equal value",
+			Old: schema.NewSet(tfmemorydb.ParameterHash, []interface{}{
+				map[string]interface{}{
+					"name":  "active-defrag-threshold-lower",
+					"value": "10",
+				},
+			}),
+			New: schema.NewSet(tfmemorydb.ParameterHash, []interface{}{
+				map[string]interface{}{
+					"name":  "active-defrag-threshold-lower",
+					"value": "10.0",
+				},
+			}),
+			ExpectedRemove:      []*memorydb.ParameterNameValue{},
+			ExpectedAddOrUpdate: []*memorydb.ParameterNameValue{},
+		},
+		{
+			Name: "Numerically different value",
+			Old: schema.NewSet(tfmemorydb.ParameterHash, []interface{}{
+				map[string]interface{}{
+					"name":  "active-defrag-threshold-lower",
+					"value": "10",
+				},
+			}),
+			New: schema.NewSet(tfmemorydb.ParameterHash, []interface{}{
+				map[string]interface{}{
+					"name":  "active-defrag-threshold-lower",
+					"value": "10.5",
+				},
+			}),
+			ExpectedRemove: []*memorydb.ParameterNameValue{},
+			ExpectedAddOrUpdate: []*memorydb.ParameterNameValue{
+				{
+					ParameterName:  aws.String("active-defrag-threshold-lower"),
+					ParameterValue: aws.String("10.5"),
+				},
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -31,6 +31,10 @@ const (
 	clusterSnsTopicStatusActive   = "ACTIVE"
 	clusterSnsTopicStatusInactive = "INACTIVE"
 
+	snapshotStatusAvailable = "available"
+	snapshotStatusCreating  = "creating"
+	snapshotStatusDeleting  = "deleting"
+
 	userStatusActive    = "active"
 	userStatusDeleting  = "deleting"
 	userStatusModifying = "modifying"
@@ -113,6 +117,23 @@ func statusClusterSecurityGroups(ctx context.Context, conn *memorydb.MemoryDB, c
 	}
 }
 
+// statusSnapshot fetches the MemoryDB snapshot and its status.
+func statusSnapshot(ctx context.Context, conn *memorydb.MemoryDB, snapshotName string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		snapshot, err := FindSnapshotByName(ctx, conn, snapshotName)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return snapshot, aws.StringValue(snapshot.Status), nil
+	}
+}
+
 // statusUser fetches the MemoryDB user and its status.
 func statusUser(ctx context.Context, conn *memorydb.MemoryDB, userName string) resource.StateRefreshFunc {
 	return func() (interface{}, string, error) {
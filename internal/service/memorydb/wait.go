@@ -19,6 +19,9 @@ const (
 
 	clusterSecurityGroupsActiveTimeout = 10 * time.Minute
 
+	snapshotAvailableTimeout = 120 * time.Minute
+	snapshotDeletedTimeout   = 120 * time.Minute
+
 	userActiveTimeout  = 5 * time.Minute
 	userDeletedTimeout = 5 * time.Minute
 )
@@ -109,6 +112,34 @@ func waitClusterSecurityGroupsActive(ctx context.Context, conn *memorydb.MemoryD
 	return err
 }
 
+// waitSnapshotAvailable waits for MemoryDB snapshot to reach the available state.
+func waitSnapshotAvailable(ctx context.Context, conn *memorydb.MemoryDB, snapshotId string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{snapshotStatusCreating},
+		Target:  []string{snapshotStatusAvailable},
+		Refresh: statusSnapshot(ctx, conn, snapshotId),
+		Timeout: snapshotAvailableTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
+// waitSnapshotDeleted waits for MemoryDB snapshot to be deleted.
+func waitSnapshotDeleted(ctx context.Context, conn *memorydb.MemoryDB, snapshotId string) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{snapshotStatusDeleting},
+		Target:  []string{},
+		Refresh: statusSnapshot(ctx, conn, snapshotId),
+		Timeout: snapshotDeletedTimeout,
+	}
+
+	_, err := stateConf.WaitForStateContext(ctx)
+
+	return err
+}
+
 // waitUserActive waits for MemoryDB user to reach an active state after modifications.
 func waitUserActive(ctx context.Context, conn *memorydb.MemoryDB, userId string) error {
 	stateConf := &resource.StateChangeConf{
@@ -153,7 +153,6 @@ func ResourceBroker() *schema.Resource {
 			"ldap_server_metadata": {
 				Type:     schema.TypeList,
 				Optional: true,
-				ForceNew: true,
 				MaxItems: 1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -330,6 +329,10 @@ func ResourceBroker() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			verify.SetTagsDiff,
+			// LDAP server metadata can be updated in place but not removed.
+			customdiff.ForceNewIfChange("ldap_server_metadata", func(_ context.Context, old, new, meta interface{}) bool {
+				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
+			}),
 			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
 				if strings.EqualFold(diff.Get("engine_type").(string), mq.EngineTypeRabbitmq) {
 					if v, ok := diff.GetOk("logs.0.audit"); ok {
@@ -529,6 +532,17 @@ func resourceBrokerUpdate(d *schema.ResourceData, meta interface{}) error {
 		requiresReboot = true
 	}
 
+	if d.HasChange("ldap_server_metadata") {
+		_, err := conn.UpdateBroker(&mq.UpdateBrokerRequest{
+			BrokerId:           aws.String(d.Id()),
+			LdapServerMetadata: expandMQLDAPServerMetadata(d.Get("ldap_server_metadata").([]interface{})),
+		})
+		if err != nil {
+			return fmt.Errorf("error updating MQ Broker (%s) LDAP server metadata: %w", d.Id(), err)
+		}
+		requiresReboot = true
+	}
+
 	if d.HasChange("user") {
 		o, n := d.GetChange("user")
 		var err error
@@ -1112,7 +1112,7 @@ func TestAccMQBroker_ldap(t *testing.T) {
 		CheckDestroy: testAccCheckBrokerDestroy,
 		Steps: []resource.TestStep{
 			{
-				Config: testAccMqBrokerConfig_ldap(rName, "anyusername"),
+				Config: testAccMqBrokerConfig_ldap(rName, "anyusername", "supersecret"),
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckBrokerExists(resourceName, &broker),
 					resource.TestCheckResourceAttr(resourceName, "auto_minor_version_upgrade", "false"),
@@ -1136,6 +1136,42 @@ func TestAccMQBroker_ldap(t *testing.T) {
 	})
 }
 
+func TestAccMQBroker_ldapUpdate(t *testing.T) {
+	var broker1, broker2 mq.DescribeBrokerResponse
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_mq_broker.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(t)
+			acctest.PreCheckPartitionHasService(mq.EndpointsID, t)
+			testAccPreCheck(t)
+		},
+		ErrorCheck:   acctest.ErrorCheck(t, mq.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBrokerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMqBrokerConfig_ldap(rName, "anyusername", "supersecret"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(resourceName, &broker1),
+					resource.TestCheckResourceAttr(resourceName, "ldap_server_metadata.0.service_account_password", "supersecret"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_server_metadata.0.service_account_username", "anyusername"),
+				),
+			},
+			{
+				Config: testAccMqBrokerConfig_ldap(rName, "otherusername", "updatedsecret"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBrokerExists(resourceName, &broker2),
+					testAccCheckBrokerNotRecreated(&broker1, &broker2),
+					resource.TestCheckResourceAttr(resourceName, "ldap_server_metadata.0.service_account_password", "updatedsecret"),
+					resource.TestCheckResourceAttr(resourceName, "ldap_server_metadata.0.service_account_username", "otherusername"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckBrokerDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn
 
@@ -1188,6 +1224,16 @@ func testAccCheckBrokerExists(name string, broker *mq.DescribeBrokerResponse) re
 	}
 }
 
+func testAccCheckBrokerNotRecreated(before, after *mq.DescribeBrokerResponse) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if before, after := aws.StringValue(before.BrokerId), aws.StringValue(after.BrokerId); before != after {
+			return fmt.Errorf("MQ Broker (%s/%s) recreated", before, after)
+		}
+
+		return nil
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).MQConn
 
@@ -1821,7 +1867,7 @@ resource "aws_mq_broker" "test" {
 `, rName)
 }
 
-func testAccMqBrokerConfig_ldap(rName, ldapUsername string) string {
+func testAccMqBrokerConfig_ldap(rName, ldapUsername, ldapPassword string) string {
 	return fmt.Sprintf(`
 resource "aws_security_group" "test" {
   name = %[1]q
@@ -1851,7 +1897,7 @@ resource "aws_mq_broker" "test" {
     role_name                = "role.name"
     role_search_matching     = "role.search.matching"
     role_search_subtree      = true
-    service_account_password = "supersecret"
+    service_account_password = %[3]q
     service_account_username = %[2]q
     user_base                = "user.base"
     user_role_name           = "user.role.name"
@@ -1859,5 +1905,5 @@ resource "aws_mq_broker" "test" {
     user_search_subtree      = true
   }
 }
-`, rName, ldapUsername)
+`, rName, ldapUsername, ldapPassword)
 }
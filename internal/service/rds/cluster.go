@@ -1,6 +1,7 @@
 package rds
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -11,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/rds"
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -476,11 +478,26 @@ func ResourceCluster() *schema.Resource {
 				Default:  false,
 			},
 
+			"performance_insights_enabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+
+			"performance_insights_retention_period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validPerformanceInsightsRetentionPeriod,
+			},
+
 			"tags":     tftags.TagsSchema(),
 			"tags_all": tftags.TagsSchemaComputed(),
 		},
 
-		CustomizeDiff: verify.SetTagsDiff,
+		CustomizeDiff: customdiff.Sequence(
+			verify.SetTagsDiff,
+			customizeDiffClusterPerformanceInsights,
+		),
 	}
 }
 
@@ -593,6 +610,15 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			opts.VpcSecurityGroupIds = flex.ExpandStringSet(attr)
 		}
 
+		if v, ok := d.GetOk("performance_insights_enabled"); ok && v.(bool) {
+			modifyDbClusterInput.EnablePerformanceInsights = aws.Bool(true)
+			requiresModifyDbCluster = true
+
+			if v, ok := d.GetOk("performance_insights_retention_period"); ok {
+				modifyDbClusterInput.PerformanceInsightsRetentionPeriod = aws.Int64(int64(v.(int)))
+			}
+		}
+
 		log.Printf("[DEBUG] RDS Cluster restore from snapshot configuration: %s", opts)
 		err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
 			_, err := conn.RestoreDBClusterFromSnapshot(&opts)
@@ -693,6 +719,15 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			createOpts.StorageEncrypted = aws.Bool(attr.(bool))
 		}
 
+		if v, ok := d.GetOk("performance_insights_enabled"); ok && v.(bool) {
+			modifyDbClusterInput.EnablePerformanceInsights = aws.Bool(true)
+			requiresModifyDbCluster = true
+
+			if v, ok := d.GetOk("performance_insights_retention_period"); ok {
+				modifyDbClusterInput.PerformanceInsightsRetentionPeriod = aws.Int64(int64(v.(int)))
+			}
+		}
+
 		log.Printf("[DEBUG] RDS Cluster restore options: %s", createOpts)
 		// Retry for IAM/S3 eventual consistency
 		var resp *rds.RestoreDBClusterFromS3Output
@@ -813,6 +848,15 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 
+		if v, ok := d.GetOk("performance_insights_enabled"); ok && v.(bool) {
+			modifyDbClusterInput.EnablePerformanceInsights = aws.Bool(true)
+			requiresModifyDbCluster = true
+
+			if v, ok := d.GetOk("performance_insights_retention_period"); ok {
+				modifyDbClusterInput.PerformanceInsightsRetentionPeriod = aws.Int64(int64(v.(int)))
+			}
+		}
+
 		log.Printf("[DEBUG] RDS Cluster restore options: %s", createOpts)
 
 		resp, err := conn.RestoreDBClusterToPointInTime(createOpts)
@@ -926,6 +970,14 @@ func resourceClusterCreate(d *schema.ResourceData, meta interface{}) error {
 			createOpts.StorageEncrypted = aws.Bool(attr.(bool))
 		}
 
+		if v, ok := d.GetOk("performance_insights_enabled"); ok {
+			createOpts.EnablePerformanceInsights = aws.Bool(v.(bool))
+		}
+
+		if v, ok := d.GetOk("performance_insights_retention_period"); ok {
+			createOpts.PerformanceInsightsRetentionPeriod = aws.Int64(int64(v.(int)))
+		}
+
 		log.Printf("[DEBUG] RDS Cluster create options: %s", createOpts)
 		var resp *rds.CreateDBClusterOutput
 		err := resource.Retry(tfiam.PropagationTimeout, func() *resource.RetryError {
@@ -1093,6 +1145,8 @@ func resourceClusterRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("kms_key_id", dbc.KmsKeyId)
 	d.Set("master_username", dbc.MasterUsername)
+	d.Set("performance_insights_enabled", dbc.PerformanceInsightsEnabled)
+	d.Set("performance_insights_retention_period", dbc.PerformanceInsightsRetentionPeriod)
 	d.Set("port", dbc.Port)
 	d.Set("preferred_backup_window", dbc.PreferredBackupWindow)
 	d.Set("preferred_maintenance_window", dbc.PreferredMaintenanceWindow)
@@ -1261,6 +1315,16 @@ func resourceClusterUpdate(d *schema.ResourceData, meta interface{}) error {
 		requestUpdate = true
 	}
 
+	if d.HasChanges("performance_insights_enabled", "performance_insights_retention_period") {
+		req.EnablePerformanceInsights = aws.Bool(d.Get("performance_insights_enabled").(bool))
+
+		if v, ok := d.GetOk("performance_insights_retention_period"); ok && d.Get("performance_insights_enabled").(bool) {
+			req.PerformanceInsightsRetentionPeriod = aws.Int64(int64(v.(int)))
+		}
+
+		requestUpdate = true
+	}
+
 	if requestUpdate {
 		err := resource.Retry(5*time.Minute, func() *resource.RetryError {
 			_, err := conn.ModifyDBCluster(req)
@@ -1534,6 +1598,22 @@ func WaitForClusterDeletion(conn *rds.RDS, id string, timeout time.Duration) err
 	return err
 }
 
+// customizeDiffClusterPerformanceInsights rejects a configured Performance Insights
+// retention period when Performance Insights is not enabled on the cluster.
+func customizeDiffClusterPerformanceInsights(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+	if diff.Get("performance_insights_enabled").(bool) {
+		return nil
+	}
+
+	// Only a value present in configuration is an error; the computed value
+	// read back from the API is left alone.
+	if v := diff.GetRawConfig().GetAttr("performance_insights_retention_period"); v.IsKnown() && !v.IsNull() {
+		return errors.New(`performance_insights_retention_period requires performance_insights_enabled to be true`)
+	}
+
+	return nil
+}
+
 func rdsClusterSetResourceDataEngineVersionFromCluster(d *schema.ResourceData, c *rds.DBCluster) {
 	oldVersion := d.Get("engine_version").(string)
 	newVersion := aws.StringValue(c.EngineVersion)
@@ -3,6 +3,7 @@ package rds
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -160,20 +161,12 @@ func resourceClusterParameterGroupRead(d *schema.ResourceData, meta interface{})
 	d.Set("family", describeResp.DBClusterParameterGroups[0].DBParameterGroupFamily)
 	d.Set("name", describeResp.DBClusterParameterGroups[0].DBClusterParameterGroupName)
 
-	// Only include user customized parameters as there's hundreds of system/default ones
-	describeParametersOpts := rds.DescribeDBClusterParametersInput{
-		DBClusterParameterGroupName: aws.String(d.Id()),
-		Source:                      aws.String("user"),
-	}
+	userDefinedParameters := createClusterParameterGroupUserDefinedParameterMap(d)
+
+	parameters, err := listClusterParameterGroupParameters(conn, d.Get("family").(string), d.Id(), userDefinedParameters)
 
-	var parameters []*rds.Parameter
-	err = conn.DescribeDBClusterParametersPages(&describeParametersOpts,
-		func(describeParametersResp *rds.DescribeDBClusterParametersOutput, lastPage bool) bool {
-			parameters = append(parameters, describeParametersResp.Parameters...)
-			return !lastPage
-		})
 	if err != nil {
-		return err
+		return fmt.Errorf("error listing parameters for DB Cluster Parameter Group (%s): %w", d.Id(), err)
 	}
 
 	if err := d.Set("parameter", FlattenParameters(parameters)); err != nil {
@@ -348,3 +341,134 @@ func resourceClusterParameterGroupDeleteRefreshFunc(
 		return d, "destroyed", nil
 	}
 }
+
+// clusterParameterGroupParametersQueryFunc returns the user-modified parameters
+// in the DB cluster parameter group with the given name.
+type clusterParameterGroupParametersQueryFunc func(parameterGroupName string) ([]*rds.Parameter, error)
+
+// clusterParameterGroupDefaultsQueryFunc returns the engine default DB cluster
+// parameters for the given family.
+type clusterParameterGroupDefaultsQueryFunc func(family string) ([]*rds.Parameter, error)
+
+// listClusterParameterGroupParameters returns the user-defined parameters in
+// the DB cluster parameter group with the given name and family.
+//
+// Parameters given in userDefined will be returned even if the value is equal
+// to the engine default, so that a parameter reset to its default outside of
+// Terraform is still read back and shows up as a difference on the next plan.
+func listClusterParameterGroupParameters(conn *rds.RDS, family, name string, userDefined map[string]string) ([]*rds.Parameter, error) {
+	query := func(parameterGroupName string) ([]*rds.Parameter, error) {
+		// Only include user customized parameters as there's hundreds of system/default ones.
+		input := &rds.DescribeDBClusterParametersInput{
+			DBClusterParameterGroupName: aws.String(parameterGroupName),
+			Source:                      aws.String("user"),
+		}
+
+		var parameters []*rds.Parameter
+
+		err := conn.DescribeDBClusterParametersPages(input, func(page *rds.DescribeDBClusterParametersOutput, lastPage bool) bool {
+			if page == nil {
+				return !lastPage
+			}
+
+			parameters = append(parameters, page.Parameters...)
+
+			return !lastPage
+		})
+
+		return parameters, err
+	}
+
+	defaultsQuery := func(family string) ([]*rds.Parameter, error) {
+		input := &rds.DescribeEngineDefaultClusterParametersInput{
+			DBParameterGroupFamily: aws.String(family),
+		}
+
+		var parameters []*rds.Parameter
+
+		for {
+			output, err := conn.DescribeEngineDefaultClusterParameters(input)
+
+			if err != nil {
+				return nil, err
+			}
+
+			if output == nil || output.EngineDefaults == nil {
+				break
+			}
+
+			parameters = append(parameters, output.EngineDefaults.Parameters...)
+
+			if aws.StringValue(output.EngineDefaults.Marker) == "" {
+				break
+			}
+
+			input.Marker = output.EngineDefaults.Marker
+		}
+
+		return parameters, nil
+	}
+
+	return listClusterParameterGroupParametersWithQuery(query, defaultsQuery, family, name, userDefined)
+}
+
+// listClusterParameterGroupParametersWithQuery implements
+// listClusterParameterGroupParameters on top of the given query functions.
+func listClusterParameterGroupParametersWithQuery(query clusterParameterGroupParametersQueryFunc, defaultsQuery clusterParameterGroupDefaultsQueryFunc, family, name string, userDefined map[string]string) ([]*rds.Parameter, error) {
+	defaults, err := defaultsQuery(family)
+
+	if err != nil {
+		return nil, fmt.Errorf("error listing engine default parameters for family %s: %w", family, err)
+	}
+
+	defaultValueByName := map[string]string{}
+	for _, parameter := range defaults {
+		defaultValueByName[strings.ToLower(aws.StringValue(parameter.ParameterName))] = aws.StringValue(parameter.ParameterValue)
+	}
+
+	current, err := query(name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var result []*rds.Parameter
+
+	for _, parameter := range current {
+		name := strings.ToLower(aws.StringValue(parameter.ParameterName))
+		currentValue := aws.StringValue(parameter.ParameterValue)
+		defaultValue, hasDefault := defaultValueByName[name]
+		_, isUserDefined := userDefined[name]
+
+		if !hasDefault || currentValue != defaultValue || isUserDefined {
+			result = append(result, parameter)
+		}
+	}
+
+	return result, nil
+}
+
+func createClusterParameterGroupUserDefinedParameterMap(d *schema.ResourceData) map[string]string {
+	result := map[string]string{}
+
+	for _, param := range d.Get("parameter").(*schema.Set).List() {
+		m, ok := param.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, ok := m["name"].(string)
+		if !ok || name == "" {
+			continue
+		}
+
+		value, ok := m["value"].(string)
+		if !ok {
+			continue
+		}
+
+		result[strings.ToLower(name)] = value
+	}
+
+	return result
+}
@@ -0,0 +1,117 @@
+package rds
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/rds"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func Test_listClusterParameterGroupParametersWithQuery(t *testing.T) {
+	current := []*rds.Parameter{
+		{ParameterName: aws.String("character_set_server"), ParameterValue: aws.String("utf8"), ApplyMethod: aws.String("immediate")},
+		{ParameterName: aws.String("Binlog_Format"), ParameterValue: aws.String("ROW"), ApplyMethod: aws.String("pending-reboot")},
+		{ParameterName: aws.String("time_zone"), ParameterValue: aws.String("UTC"), ApplyMethod: aws.String("immediate")},
+		{ParameterName: aws.String("custom"), ParameterValue: aws.String("1"), ApplyMethod: aws.String("immediate")},
+	}
+
+	defaults := []*rds.Parameter{
+		{ParameterName: aws.String("character_set_server"), ParameterValue: aws.String("latin1")},
+		{ParameterName: aws.String("binlog_format"), ParameterValue: aws.String("ROW")},
+		{ParameterName: aws.String("time_zone"), ParameterValue: aws.String("UTC")},
+	}
+
+	cases := []struct {
+		Name        string
+		Defaults    []*rds.Parameter
+		DefaultsErr error
+		UserDefined map[string]string
+		Expected    []map[string]interface{}
+		ExpectedErr bool
+	}{
+		{
+			Name:        "Defaults omitted",
+			Defaults:    defaults,
+			UserDefined: map[string]string{},
+			Expected: []map[string]interface{}{
+				{"name": "character_set_server", "value": "utf8", "apply_method": "immediate"},
+				{"name": "custom", "value": "1", "apply_method": "immediate"},
+			},
+		},
+		{
+			Name:        "User-defined defaults kept",
+			Defaults:    defaults,
+			UserDefined: map[string]string{"binlog_format": "ROW"},
+			Expected: []map[string]interface{}{
+				{"name": "binlog_format", "value": "ROW", "apply_method": "pending-reboot"},
+				{"name": "character_set_server", "value": "utf8", "apply_method": "immediate"},
+				{"name": "custom", "value": "1", "apply_method": "immediate"},
+			},
+		},
+		{
+			Name:        "No engine defaults",
+			Defaults:    nil,
+			UserDefined: map[string]string{},
+			Expected: []map[string]interface{}{
+				{"name": "binlog_format", "value": "ROW", "apply_method": "pending-reboot"},
+				{"name": "character_set_server", "value": "utf8", "apply_method": "immediate"},
+				{"name": "custom", "value": "1", "apply_method": "immediate"},
+				{"name": "time_zone", "value": "UTC", "apply_method": "immediate"},
+			},
+		},
+		{
+			Name:        "Engine defaults error",
+			DefaultsErr: awserr.New("InvalidParameterValue", "invalid family", nil),
+			UserDefined: map[string]string{},
+			ExpectedErr: true,
+		},
+	}
+
+	for _, tc := range cases {
+		query := func(parameterGroupName string) ([]*rds.Parameter, error) {
+			if parameterGroupName != "test" {
+				t.Fatalf("Case %q: unexpected parameter group name %q", tc.Name, parameterGroupName)
+			}
+
+			return current, nil
+		}
+
+		defaultsQuery := func(family string) ([]*rds.Parameter, error) {
+			if family != "aurora-mysql5.7" {
+				t.Fatalf("Case %q: unexpected family %q", tc.Name, family)
+			}
+
+			return tc.Defaults, tc.DefaultsErr
+		}
+
+		got, err := listClusterParameterGroupParametersWithQuery(query, defaultsQuery, "aurora-mysql5.7", "test", tc.UserDefined)
+
+		if tc.ExpectedErr {
+			if err == nil {
+				t.Errorf("Case %q: expected error, got none", tc.Name)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Case %q: unexpected error: %s", tc.Name, err)
+			continue
+		}
+
+		gotSet := schema.NewSet(resourceParameterHash, nil)
+		for _, v := range FlattenParameters(got) {
+			gotSet.Add(v)
+		}
+
+		expectedSet := schema.NewSet(resourceParameterHash, nil)
+		for _, v := range tc.Expected {
+			expectedSet.Add(v)
+		}
+
+		if !gotSet.Equal(expectedSet) {
+			t.Errorf("Case %q: parameters did not match\n%#v\n\nGot:\n%#v", tc.Name, expectedSet.List(), gotSet.List())
+		}
+	}
+}
@@ -188,3 +188,15 @@ func validIdentifierPrefix(v interface{}, k string) (ws []string, errors []error
 	}
 	return
 }
+
+// validPerformanceInsightsRetentionPeriod validates the Performance Insights retention
+// period in days: 7 (the default), a multiple of 31 for 1-23 months, or 731 for 2 years.
+func validPerformanceInsightsRetentionPeriod(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(int)
+	if value == 7 || value == 731 || (value%31 == 0 && value >= 31 && value <= 713) {
+		return
+	}
+	errors = append(errors, fmt.Errorf(
+		"%q must be 7, 731, or a multiple of 31 between 31 and 713, got: %d", k, value))
+	return
+}
@@ -211,3 +211,55 @@ func TestValidSubnetGroupNamePrefix(t *testing.T) {
 		}
 	}
 }
+
+func TestValidPerformanceInsightsRetentionPeriod(t *testing.T) {
+	cases := []struct {
+		Value    int
+		ErrCount int
+	}{
+		{
+			Value:    7,
+			ErrCount: 0,
+		},
+		{
+			Value:    31,
+			ErrCount: 0,
+		},
+		{
+			Value:    372,
+			ErrCount: 0,
+		},
+		{
+			Value:    713,
+			ErrCount: 0,
+		},
+		{
+			Value:    731,
+			ErrCount: 0,
+		},
+		{
+			Value:    0,
+			ErrCount: 1,
+		},
+		{
+			Value:    30,
+			ErrCount: 1,
+		},
+		{
+			Value:    365,
+			ErrCount: 1,
+		},
+		{
+			Value:    744,
+			ErrCount: 1,
+		},
+	}
+
+	for _, tc := range cases {
+		_, errors := validPerformanceInsightsRetentionPeriod(tc.Value, "performance_insights_retention_period")
+
+		if len(errors) != tc.ErrCount {
+			t.Fatalf("Expected %d validation errors for %d, got %d", tc.ErrCount, tc.Value, len(errors))
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package redshift
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourceDataShareConsumerAssociation() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceDataShareConsumerAssociationCreate,
+		Read:   resourceDataShareConsumerAssociationRead,
+		Delete: resourceDataShareConsumerAssociationDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"associate_entire_account": {
+				Type:         schema.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"associate_entire_account", "consumer_arn"},
+			},
+			"consumer_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+				ExactlyOneOf: []string{"associate_entire_account", "consumer_arn"},
+			},
+			"data_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"producer_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceDataShareConsumerAssociationCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	dataShareARN := d.Get("data_share_arn").(string)
+	associateEntireAccount := d.Get("associate_entire_account").(bool)
+	consumerARN := d.Get("consumer_arn").(string)
+	id := DataShareConsumerAssociationCreateID(dataShareARN, associateEntireAccount, consumerARN)
+
+	input := &redshift.AssociateDataShareConsumerInput{
+		DataShareArn: aws.String(dataShareARN),
+	}
+
+	if associateEntireAccount {
+		input.AssociateEntireAccount = aws.Bool(true)
+	}
+
+	if consumerARN != "" {
+		input.ConsumerArn = aws.String(consumerARN)
+	}
+
+	log.Printf("[DEBUG] Creating Redshift Data Share Consumer Association: %s", input)
+	_, err := conn.AssociateDataShareConsumer(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating Redshift Data Share Consumer Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+
+	if _, err := waitDataShareConsumerAssociationActive(conn, dataShareARN, dataShareConsumerIdentifier(meta, consumerARN), dataShareConsumerAssociationActiveTimeout); err != nil {
+		return fmt.Errorf("error waiting for Redshift Data Share Consumer Association (%s) to become active: %w", d.Id(), err)
+	}
+
+	return resourceDataShareConsumerAssociationRead(d, meta)
+}
+
+func resourceDataShareConsumerAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	dataShareARN, associateEntireAccount, consumerARN, err := DataShareConsumerAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	dataShare, association, err := FindDataShareConsumerAssociation(conn, dataShareARN, dataShareConsumerIdentifier(meta, consumerARN))
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Data Share Consumer Association (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Data Share Consumer Association (%s): %w", d.Id(), err)
+	}
+
+	if status := aws.StringValue(association.Status); !d.IsNewResource() && status != redshift.DataShareStatusActive {
+		log.Printf("[WARN] Redshift Data Share Consumer Association (%s) is %s, removing from state", d.Id(), status)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("associate_entire_account", associateEntireAccount)
+	d.Set("consumer_arn", consumerARN)
+	d.Set("data_share_arn", dataShareARN)
+	d.Set("producer_arn", dataShare.ProducerArn)
+	d.Set("status", association.Status)
+
+	return nil
+}
+
+func resourceDataShareConsumerAssociationDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	dataShareARN, associateEntireAccount, consumerARN, err := DataShareConsumerAssociationParseID(d.Id())
+
+	if err != nil {
+		return err
+	}
+
+	input := &redshift.DisassociateDataShareConsumerInput{
+		DataShareArn: aws.String(dataShareARN),
+	}
+
+	if associateEntireAccount {
+		input.DisassociateEntireAccount = aws.Bool(true)
+	}
+
+	if consumerARN != "" {
+		input.ConsumerArn = aws.String(consumerARN)
+	}
+
+	log.Printf("[DEBUG] Deleting Redshift Data Share Consumer Association: %s", d.Id())
+	_, err = conn.DisassociateDataShareConsumer(input)
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting Redshift Data Share Consumer Association (%s): %w", d.Id(), err)
+	}
+
+	if _, err := waitDataShareConsumerAssociationDeleted(conn, dataShareARN, dataShareConsumerIdentifier(meta, consumerARN), dataShareConsumerAssociationDeletedTimeout); err != nil {
+		return fmt.Errorf("error waiting for Redshift Data Share Consumer Association (%s) delete: %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+// dataShareConsumerIdentifier returns the identifier under which the data share
+// lists an association: the consumer ARN if one was given, otherwise the
+// caller's account ID.
+func dataShareConsumerIdentifier(meta interface{}, consumerARN string) string {
+	if consumerARN != "" {
+		return consumerARN
+	}
+
+	return meta.(*conns.AWSClient).AccountID
+}
@@ -0,0 +1,72 @@
+package redshift
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func DataSourceDataShareConsumerAssociation() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceDataShareConsumerAssociationRead,
+
+		Schema: map[string]*schema.Schema{
+			"consumer_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"created_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"data_share_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"producer_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status_change_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceDataShareConsumerAssociationRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).RedshiftConn
+
+	dataShareARN := d.Get("data_share_arn").(string)
+	consumerARN := d.Get("consumer_arn").(string)
+	id := DataShareConsumerAssociationCreateID(dataShareARN, consumerARN == "", consumerARN)
+
+	dataShare, association, err := FindDataShareConsumerAssociation(conn, dataShareARN, dataShareConsumerIdentifier(meta, consumerARN))
+
+	if err != nil {
+		return fmt.Errorf("error reading Redshift Data Share Consumer Association (%s): %w", id, err)
+	}
+
+	d.SetId(id)
+	if v := association.CreatedDate; v != nil {
+		d.Set("created_date", aws.TimeValue(v).Format(time.RFC3339))
+	}
+	d.Set("producer_arn", dataShare.ProducerArn)
+	d.Set("status", association.Status)
+	if v := association.StatusChangeDate; v != nil {
+		d.Set("status_change_date", aws.TimeValue(v).Format(time.RFC3339))
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package redshift_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/redshift"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccRedshiftDataShareConsumerAssociationDataSource_basic(t *testing.T) {
+	dataShareARN := testAccPreCheckDataShareARN(t)
+	dataSourceName := "data.aws_redshift_data_share_consumer_association.test"
+	resourceName := "aws_redshift_data_share_consumer_association.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:   func() { acctest.PreCheck(t) },
+		ErrorCheck: acctest.ErrorCheck(t, redshift.EndpointsID),
+		Providers:  acctest.Providers,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataShareConsumerAssociationDataSourceConfig(dataShareARN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "data_share_arn", resourceName, "data_share_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "producer_arn", resourceName, "producer_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "status", resourceName, "status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "created_date"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataShareConsumerAssociationDataSourceConfig(dataShareARN string) string {
+	return acctest.ConfigCompose(testAccDataShareConsumerAssociationConfig_associateEntireAccount(dataShareARN), `
+data "aws_redshift_data_share_consumer_association" "test" {
+  data_share_arn = aws_redshift_data_share_consumer_association.test.data_share_arn
+}
+`)
+}
@@ -65,3 +65,58 @@ func FindScheduledActionByName(conn *redshift.Redshift, name string) (*redshift.
 
 	return output.ScheduledActions[0], nil
 }
+
+func FindDataShareConsumerAssociation(conn *redshift.Redshift, dataShareARN, consumerIdentifier string) (*redshift.DataShare, *redshift.DataShareAssociation, error) {
+	input := &redshift.DescribeDataSharesInput{
+		DataShareArn: aws.String(dataShareARN),
+	}
+
+	var dataShare *redshift.DataShare
+
+	err := conn.DescribeDataSharesPages(input, func(page *redshift.DescribeDataSharesOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.DataShares {
+			if v != nil && aws.StringValue(v.DataShareArn) == dataShareARN {
+				dataShare = v
+
+				return false
+			}
+		}
+
+		return !lastPage
+	})
+
+	if tfawserr.ErrCodeEquals(err, redshift.ErrCodeInvalidDataShareFault) {
+		return nil, nil, &resource.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if dataShare == nil {
+		return nil, nil, tfresource.NewEmptyResultError(input)
+	}
+
+	for _, v := range dataShare.DataShareAssociations {
+		if v == nil {
+			continue
+		}
+
+		if aws.StringValue(v.ConsumerIdentifier) != consumerIdentifier {
+			continue
+		}
+
+		return dataShare, v, nil
+	}
+
+	return nil, nil, &resource.NotFoundError{
+		LastRequest: input,
+	}
+}
@@ -0,0 +1,30 @@
+package redshift
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const dataShareConsumerAssociationIDSeparator = ","
+
+func DataShareConsumerAssociationCreateID(dataShareARN string, associateEntireAccount bool, consumerARN string) string {
+	parts := []string{dataShareARN, strconv.FormatBool(associateEntireAccount), consumerARN}
+	id := strings.Join(parts, dataShareConsumerAssociationIDSeparator)
+
+	return id
+}
+
+func DataShareConsumerAssociationParseID(id string) (string, bool, string, error) {
+	parts := strings.Split(id, dataShareConsumerAssociationIDSeparator)
+
+	if len(parts) == 3 && parts[0] != "" {
+		associateEntireAccount, err := strconv.ParseBool(parts[1])
+
+		if err == nil {
+			return parts[0], associateEntireAccount, parts[2], nil
+		}
+	}
+
+	return "", false, "", fmt.Errorf("unexpected format for ID (%[1]s), expected data-share-arn%[2]sassociate-entire-account%[2]sconsumer-arn", id, dataShareConsumerAssociationIDSeparator)
+}
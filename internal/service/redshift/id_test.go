@@ -0,0 +1,74 @@
+package redshift_test
+
+import (
+	"testing"
+
+	tfredshift "github.com/hashicorp/terraform-provider-aws/internal/service/redshift"
+)
+
+func TestDataShareConsumerAssociationParseID(t *testing.T) {
+	dataShareARN := "arn:aws:redshift:us-west-2:123456789012:datashare:b3bfde75-73fd-408b-9086-d6fccfd6d588/example"
+
+	testCases := []struct {
+		TestName                       string
+		InputID                        string
+		ExpectedError                  bool
+		ExpectedDataShareARN           string
+		ExpectedAssociateEntireAccount bool
+		ExpectedConsumerARN            string
+	}{
+		{
+			TestName:      "empty",
+			InputID:       "",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "too few parts",
+			InputID:       dataShareARN + ",true",
+			ExpectedError: true,
+		},
+		{
+			TestName:      "invalid boolean",
+			InputID:       dataShareARN + ",yes,",
+			ExpectedError: true,
+		},
+		{
+			TestName:                       "entire account",
+			InputID:                        tfredshift.DataShareConsumerAssociationCreateID(dataShareARN, true, ""),
+			ExpectedDataShareARN:           dataShareARN,
+			ExpectedAssociateEntireAccount: true,
+		},
+		{
+			TestName:             "consumer ARN",
+			InputID:              tfredshift.DataShareConsumerAssociationCreateID(dataShareARN, false, "arn:aws:redshift-serverless:us-west-2:123456789012:namespace/test"),
+			ExpectedDataShareARN: dataShareARN,
+			ExpectedConsumerARN:  "arn:aws:redshift-serverless:us-west-2:123456789012:namespace/test",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			gotDataShareARN, gotAssociateEntireAccount, gotConsumerARN, err := tfredshift.DataShareConsumerAssociationParseID(testCase.InputID)
+
+			if err == nil && testCase.ExpectedError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.ExpectedError {
+				t.Fatalf("got unexpected error: %s", err)
+			}
+
+			if gotDataShareARN != testCase.ExpectedDataShareARN {
+				t.Errorf("got data share ARN %s, expected %s", gotDataShareARN, testCase.ExpectedDataShareARN)
+			}
+
+			if gotAssociateEntireAccount != testCase.ExpectedAssociateEntireAccount {
+				t.Errorf("got associate entire account %t, expected %t", gotAssociateEntireAccount, testCase.ExpectedAssociateEntireAccount)
+			}
+
+			if gotConsumerARN != testCase.ExpectedConsumerARN {
+				t.Errorf("got consumer ARN %s, expected %s", gotConsumerARN, testCase.ExpectedConsumerARN)
+			}
+		})
+	}
+}
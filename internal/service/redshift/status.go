@@ -22,3 +22,19 @@ func statusCluster(conn *redshift.Redshift, id string) resource.StateRefreshFunc
 		return output, aws.StringValue(output.ClusterStatus), nil
 	}
 }
+
+func statusDataShareConsumerAssociation(conn *redshift.Redshift, dataShareARN, consumerIdentifier string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		_, output, err := FindDataShareConsumerAssociation(conn, dataShareARN, consumerIdentifier)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.StringValue(output.Status), nil
+	}
+}
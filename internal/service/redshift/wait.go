@@ -9,6 +9,9 @@ import (
 
 const (
 	clusterInvalidClusterStateFaultTimeout = 15 * time.Minute
+
+	dataShareConsumerAssociationActiveTimeout  = 10 * time.Minute
+	dataShareConsumerAssociationDeletedTimeout = 10 * time.Minute
 )
 
 func waitClusterDeleted(conn *redshift.Redshift, id string, timeout time.Duration) (*redshift.Cluster, error) {
@@ -35,3 +38,50 @@ func waitClusterDeleted(conn *redshift.Redshift, id string, timeout time.Duratio
 
 	return nil, err
 }
+
+func waitDataShareConsumerAssociationActive(conn *redshift.Redshift, dataShareARN, consumerIdentifier string, timeout time.Duration) (*redshift.DataShareAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{
+			redshift.DataShareStatusAuthorized,
+			redshift.DataShareStatusAvailable,
+			redshift.DataShareStatusPendingAuthorization,
+		},
+		Target:  []string{redshift.DataShareStatusActive},
+		Refresh: statusDataShareConsumerAssociation(conn, dataShareARN, consumerIdentifier),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*redshift.DataShareAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDataShareConsumerAssociationDeleted(conn *redshift.Redshift, dataShareARN, consumerIdentifier string, timeout time.Duration) (*redshift.DataShareAssociation, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{redshift.DataShareStatusActive},
+		Target:  []string{},
+		Refresh: func() (interface{}, string, error) {
+			output, status, err := statusDataShareConsumerAssociation(conn, dataShareARN, consumerIdentifier)()
+
+			// Once disassociated the data share reverts to being available to the consumer.
+			if status == redshift.DataShareStatusAvailable {
+				return nil, "", err
+			}
+
+			return output, status, err
+		},
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForState()
+
+	if output, ok := outputRaw.(*redshift.DataShareAssociation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -282,6 +282,16 @@ func ResourceBucket() *schema.Resource {
 							Optional: true,
 						},
 						"tags": tftags.TagsSchema(),
+						"object_size_greater_than": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						"object_size_less_than": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
 						"enabled": {
 							Type:     schema.TypeBool,
 							Required: true,
@@ -1170,6 +1180,13 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 					if len(filter.And.Tags) > 0 {
 						rule["tags"] = KeyValueTags(filter.And.Tags).IgnoreAWS().Map()
 					}
+					// Object size
+					if filter.And.ObjectSizeGreaterThan != nil {
+						rule["object_size_greater_than"] = int(aws.Int64Value(filter.And.ObjectSizeGreaterThan))
+					}
+					if filter.And.ObjectSizeLessThan != nil {
+						rule["object_size_less_than"] = int(aws.Int64Value(filter.And.ObjectSizeLessThan))
+					}
 				} else {
 					// Prefix
 					if filter.Prefix != nil && aws.StringValue(filter.Prefix) != "" {
@@ -1179,6 +1196,13 @@ func resourceBucketRead(d *schema.ResourceData, meta interface{}) error {
 					if filter.Tag != nil {
 						rule["tags"] = KeyValueTags([]*s3.Tag{filter.Tag}).IgnoreAWS().Map()
 					}
+					// Object size
+					if filter.ObjectSizeGreaterThan != nil {
+						rule["object_size_greater_than"] = int(aws.Int64Value(filter.ObjectSizeGreaterThan))
+					}
+					if filter.ObjectSizeLessThan != nil {
+						rule["object_size_less_than"] = int(aws.Int64Value(filter.ObjectSizeLessThan))
+					}
 				}
 			} else {
 				if lifecycleRule.Prefix != nil {
@@ -2228,15 +2252,39 @@ func resourceBucketLifecycleUpdate(conn *s3.S3, d *schema.ResourceData) error {
 		rule := &s3.LifecycleRule{}
 
 		// Filter
+		prefix := r["prefix"].(string)
 		tags := Tags(tftags.New(r["tags"]).IgnoreAWS())
+		objectSizeGreaterThan := int64(r["object_size_greater_than"].(int))
+		objectSizeLessThan := int64(r["object_size_less_than"].(int))
+
+		// Multiple filter conditions must be wrapped in an And operator.
+		conditions := 0
+		for _, ok := range []bool{prefix != "", len(tags) > 0, objectSizeGreaterThan > 0, objectSizeLessThan > 0} {
+			if ok {
+				conditions++
+			}
+		}
+
 		filter := &s3.LifecycleRuleFilter{}
-		if len(tags) > 0 {
+		if len(tags) > 0 || conditions > 1 {
 			lifecycleRuleAndOp := &s3.LifecycleRuleAndOperator{}
-			lifecycleRuleAndOp.SetPrefix(r["prefix"].(string))
-			lifecycleRuleAndOp.SetTags(tags)
+			lifecycleRuleAndOp.SetPrefix(prefix)
+			if len(tags) > 0 {
+				lifecycleRuleAndOp.SetTags(tags)
+			}
+			if objectSizeGreaterThan > 0 {
+				lifecycleRuleAndOp.SetObjectSizeGreaterThan(objectSizeGreaterThan)
+			}
+			if objectSizeLessThan > 0 {
+				lifecycleRuleAndOp.SetObjectSizeLessThan(objectSizeLessThan)
+			}
 			filter.SetAnd(lifecycleRuleAndOp)
+		} else if objectSizeGreaterThan > 0 {
+			filter.SetObjectSizeGreaterThan(objectSizeGreaterThan)
+		} else if objectSizeLessThan > 0 {
+			filter.SetObjectSizeLessThan(objectSizeLessThan)
 		} else {
-			filter.SetPrefix(r["prefix"].(string))
+			filter.SetPrefix(prefix)
 		}
 		rule.SetFilter(filter)
 
@@ -1,6 +1,7 @@
 package s3
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
 	"time"
@@ -39,7 +40,7 @@ func ResourceBucketPolicy() *schema.Resource {
 				Type:             schema.TypeString,
 				Required:         true,
 				ValidateFunc:     validation.StringIsJSON,
-				DiffSuppressFunc: verify.SuppressEquivalentPolicyDiffs,
+				DiffSuppressFunc: suppressEquivalentBucketPolicyDiffs,
 			},
 		},
 	}
@@ -121,6 +122,18 @@ func resourceBucketPolicyRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// suppressEquivalentBucketPolicyDiffs suppresses policy diffs that the policy
+// equivalence library considers equal. Policies it cannot compare (e.g. unusual
+// statement shapes) are still compared as plain JSON so that key ordering and
+// whitespace alone never produce a diff.
+func suppressEquivalentBucketPolicyDiffs(k, old, new string, d *schema.ResourceData) bool {
+	if verify.SuppressEquivalentPolicyDiffs(k, old, new, d) {
+		return true
+	}
+
+	return policyJSONEquivalent(old, new)
+}
+
 func resourceBucketPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 	conn := meta.(*conns.AWSClient).S3Conn
 
@@ -140,3 +153,37 @@ func resourceBucketPolicyDelete(d *schema.ResourceData, meta interface{}) error
 
 	return nil
 }
+
+// policyJSONEquivalent returns whether the two JSON documents are semantically
+// equal once decoded and re-encoded with sorted object keys.
+func policyJSONEquivalent(old, new string) bool {
+	normalize := func(s string) (string, bool) {
+		var v interface{}
+
+		if err := json.Unmarshal([]byte(s), &v); err != nil {
+			return "", false
+		}
+
+		b, err := json.Marshal(v)
+
+		if err != nil {
+			return "", false
+		}
+
+		return string(b), true
+	}
+
+	o, ok := normalize(old)
+
+	if !ok {
+		return false
+	}
+
+	n, ok := normalize(new)
+
+	if !ok {
+		return false
+	}
+
+	return o == n
+}
@@ -0,0 +1,164 @@
+package s3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func Test_policyJSONEquivalent(t *testing.T) {
+	cases := []struct {
+		Name     string
+		Old      string
+		New      string
+		Expected bool
+	}{
+		{
+			Name:     "identical",
+			Old:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::test/*"}]}`,
+			New:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::test/*"}]}`,
+			Expected: true,
+		},
+		{
+			Name:     "reordered keys",
+			Old:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::test/*"}]}`,
+			New:      `{"Statement":[{"Resource":"arn:aws:s3:::test/*","Action":"s3:GetObject","Effect":"Allow"}],"Version":"2012-10-17"}`,
+			Expected: true,
+		},
+		{
+			Name: "extra whitespace",
+			Old:  `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::test/*"}]}`,
+			New: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::test/*"
+    }
+  ]
+}
+`,
+			Expected: true,
+		},
+		{
+			Name:     "different action",
+			Old:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::test/*"}]}`,
+			New:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:PutObject","Resource":"arn:aws:s3:::test/*"}]}`,
+			Expected: false,
+		},
+		{
+			Name:     "different effect",
+			Old:      `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":"arn:aws:s3:::test/*"}]}`,
+			New:      `{"Version":"2012-10-17","Statement":[{"Effect":"Deny","Action":"s3:GetObject","Resource":"arn:aws:s3:::test/*"}]}`,
+			Expected: false,
+		},
+		{
+			Name:     "reordered statements",
+			Old:      `{"Statement":[{"Sid":"a","Effect":"Allow"},{"Sid":"b","Effect":"Deny"}]}`,
+			New:      `{"Statement":[{"Sid":"b","Effect":"Deny"},{"Sid":"a","Effect":"Allow"}]}`,
+			Expected: false,
+		},
+		{
+			Name:     "empty old",
+			Old:      ``,
+			New:      `{"Version":"2012-10-17","Statement":[]}`,
+			Expected: false,
+		},
+		{
+			Name:     "invalid JSON",
+			Old:      `{"Version":"2012-10-17"`,
+			New:      `{"Version":"2012-10-17"}`,
+			Expected: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			if got := policyJSONEquivalent(tc.Old, tc.New); got != tc.Expected {
+				t.Errorf("policyJSONEquivalent(%q, %q) = %t, want %t", tc.Old, tc.New, got, tc.Expected)
+			}
+			if got := policyJSONEquivalent(tc.New, tc.Old); got != tc.Expected {
+				t.Errorf("policyJSONEquivalent(%q, %q) = %t, want %t", tc.New, tc.Old, got, tc.Expected)
+			}
+		})
+	}
+}
+
+func TestResourceBucketPolicyDiffPolicy(t *testing.T) {
+	const current = `{"Version":"2012-10-17","Statement":[{"Sid":"AllowGet","Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::test/*"}]}`
+
+	cases := []struct {
+		Name         string
+		Policy       string
+		ExpectChange bool
+	}{
+		{
+			Name:   "identical",
+			Policy: current,
+		},
+		{
+			Name:   "reordered keys",
+			Policy: `{"Statement":[{"Resource":"arn:aws:s3:::test/*","Action":"s3:GetObject","Principal":"*","Effect":"Allow","Sid":"AllowGet"}],"Version":"2012-10-17"}`,
+		},
+		{
+			Name: "extra whitespace",
+			Policy: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "AllowGet",
+      "Effect": "Allow",
+      "Principal": "*",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::test/*"
+    }
+  ]
+}
+`,
+		},
+		{
+			Name:         "different action",
+			Policy:       `{"Version":"2012-10-17","Statement":[{"Sid":"AllowGet","Effect":"Allow","Principal":"*","Action":"s3:PutObject","Resource":"arn:aws:s3:::test/*"}]}`,
+			ExpectChange: true,
+		},
+		{
+			Name:         "different resource",
+			Policy:       `{"Version":"2012-10-17","Statement":[{"Sid":"AllowGet","Effect":"Allow","Principal":"*","Action":"s3:GetObject","Resource":"arn:aws:s3:::other/*"}]}`,
+			ExpectChange: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			state := &terraform.InstanceState{
+				ID: "test",
+				Attributes: map[string]string{
+					"id":     "test",
+					"bucket": "test",
+					"policy": current,
+				},
+			}
+			config := terraform.NewResourceConfigRaw(map[string]interface{}{
+				"bucket": "test",
+				"policy": tc.Policy,
+			})
+
+			diff, err := ResourceBucketPolicy().Diff(context.Background(), state, config, nil)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			changed := false
+			if diff != nil {
+				_, changed = diff.GetAttribute("policy")
+			}
+
+			if changed != tc.ExpectChange {
+				t.Errorf("policy changed = %t, want %t", changed, tc.ExpectChange)
+			}
+		})
+	}
+}
@@ -1454,6 +1454,52 @@ func TestAccS3Bucket_Manage_lifecycleRuleAbortIncompleteMultipartUploadDaysNoExp
 	})
 }
 
+func TestAccS3Bucket_Manage_lifecycleRuleObjectSize(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket.bucket"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketLifecycleRuleObjectSizeConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.prefix", ""),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.object_size_greater_than", "500"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.object_size_less_than", "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy", "acl"},
+			},
+			{
+				Config: testAccBucketLifecycleRuleObjectSizePrefixConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.object_size_greater_than", "500"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.object_size_less_than", "64000"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force_destroy", "acl"},
+			},
+		},
+	})
+}
+
 func TestAccS3Bucket_Replication_basic(t *testing.T) {
 	rInt := sdkacctest.RandInt()
 	alternateRegion := acctest.AlternateRegion()
@@ -4161,6 +4207,44 @@ resource "aws_s3_bucket" "bucket" {
 `, rName)
 }
 
+func testAccBucketLifecycleRuleObjectSizeConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = %[1]q
+
+  lifecycle_rule {
+    enabled                  = true
+    id                       = "id1"
+    object_size_greater_than = 500
+
+    expiration {
+      days = 30
+    }
+  }
+}
+`, rName)
+}
+
+func testAccBucketLifecycleRuleObjectSizePrefixConfig(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "bucket" {
+  bucket = %[1]q
+
+  lifecycle_rule {
+    enabled                  = true
+    id                       = "id1"
+    prefix                   = "logs/"
+    object_size_greater_than = 500
+    object_size_less_than    = 64000
+
+    expiration {
+      days = 30
+    }
+  }
+}
+`, rName)
+}
+
 func testAccBucketReplicationBasicConfig(randInt int) string {
 	return acctest.ConfigAlternateRegionProvider() + fmt.Sprintf(`
 data "aws_partition" "current" {}
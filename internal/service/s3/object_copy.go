@@ -89,9 +89,10 @@ func ResourceObjectCopy() *schema.Resource {
 				ValidateFunc: validation.IsRFC3339Time,
 			},
 			"customer_algorithm": {
-				Type:     schema.TypeString,
-				Optional: true,
-				Computed: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{s3.ServerSideEncryptionAes256}, false),
 			},
 			"customer_key": {
 				Type:      schema.TypeString,
@@ -247,8 +248,9 @@ func ResourceObjectCopy() *schema.Resource {
 				ValidateFunc: validation.NoZeroValues,
 			},
 			"source_customer_algorithm": {
-				Type:     schema.TypeString,
-				Optional: true,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{s3.ServerSideEncryptionAes256}, false),
 			},
 			"source_customer_key": {
 				Type:      schema.TypeString,
@@ -303,11 +305,19 @@ func resourceObjectCopyRead(d *schema.ResourceData, meta interface{}) error {
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
-	resp, err := conn.HeadObject(
-		&s3.HeadObjectInput{
-			Bucket: aws.String(bucket),
-			Key:    aws.String(key),
-		})
+	input := &s3.HeadObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	}
+
+	// Objects encrypted with a customer-provided key can only be read by
+	// supplying the same key.
+	if v, ok := d.GetOk("customer_key"); ok {
+		input.SSECustomerAlgorithm = aws.String(d.Get("customer_algorithm").(string))
+		input.SSECustomerKey = aws.String(v.(string))
+	}
+
+	resp, err := conn.HeadObject(input)
 
 	if !d.IsNewResource() && tfawserr.ErrStatusCodeEquals(err, http.StatusNotFound) {
 		log.Printf("[WARN] S3 Object (%s) not found, removing from state", d.Id())
@@ -331,6 +341,8 @@ func resourceObjectCopyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("content_encoding", resp.ContentEncoding)
 	d.Set("content_language", resp.ContentLanguage)
 	d.Set("content_type", resp.ContentType)
+	d.Set("customer_algorithm", resp.SSECustomerAlgorithm)
+	d.Set("customer_key_md5", resp.SSECustomerKeyMD5)
 	metadata := flex.PointersMapToStringList(resp.Metadata)
 
 	// AWS Go SDK capitalizes metadata, this is a workaround. https://github.com/aws/aws-sdk-go/issues/445
@@ -647,7 +659,7 @@ func resourceObjectCopyDoCopy(d *schema.ResourceData, meta interface{}) error {
 	d.Set("version_id", output.VersionId)
 
 	d.SetId(d.Get("key").(string))
-	return resourceBucketObjectRead(d, meta)
+	return resourceObjectCopyRead(d, meta)
 }
 
 type s3Grants struct {
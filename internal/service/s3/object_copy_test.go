@@ -83,6 +83,58 @@ func TestAccS3ObjectCopy_BucketKeyEnabled_object(t *testing.T) {
 	})
 }
 
+func TestAccS3ObjectCopy_serverSideEncryption(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object_copy.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectCopyConfig_ServerSideEncryption(rName, s3.ServerSideEncryptionAes256),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "server_side_encryption", s3.ServerSideEncryptionAes256),
+				),
+			},
+			{
+				Config: testAccObjectCopyConfig_ServerSideEncryptionKMS(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "server_side_encryption", s3.ServerSideEncryptionAwsKms),
+					resource.TestCheckResourceAttrPair(resourceName, "kms_key_id", "aws_kms_key.test", "arn"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3ObjectCopy_customerKey(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_object_copy.test"
+	customerKey := sdkacctest.RandString(32)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, s3.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckObjectCopyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccObjectCopyConfig_CustomerKey(rName, customerKey),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckObjectCopyExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "customer_algorithm", s3.ServerSideEncryptionAes256),
+					resource.TestCheckResourceAttrSet(resourceName, "customer_key_md5"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckObjectCopyDestroy(s *terraform.State) error {
 	conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
 
@@ -116,12 +168,18 @@ func testAccCheckObjectCopyExists(n string) resource.TestCheckFunc {
 		}
 
 		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Conn
-		_, err := conn.GetObject(
-			&s3.GetObjectInput{
-				Bucket:  aws.String(rs.Primary.Attributes["bucket"]),
-				Key:     aws.String(rs.Primary.Attributes["key"]),
-				IfMatch: aws.String(rs.Primary.Attributes["etag"]),
-			})
+		input := &s3.GetObjectInput{
+			Bucket:  aws.String(rs.Primary.Attributes["bucket"]),
+			Key:     aws.String(rs.Primary.Attributes["key"]),
+			IfMatch: aws.String(rs.Primary.Attributes["etag"]),
+		}
+
+		if v := rs.Primary.Attributes["customer_key"]; v != "" {
+			input.SSECustomerAlgorithm = aws.String(rs.Primary.Attributes["customer_algorithm"])
+			input.SSECustomerKey = aws.String(v)
+		}
+
+		_, err := conn.GetObject(input)
 		if err != nil {
 			return fmt.Errorf("S3Bucket Object error: %s", err)
 		}
@@ -229,3 +287,85 @@ resource "aws_s3_object_copy" "test" {
 }
 `, rName)
 }
+
+func testAccObjectCopyConfig_ServerSideEncryption(rName, serverSideEncryption string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket = "%[1]s-source"
+}
+
+resource "aws_s3_bucket_object" "source" {
+  bucket  = aws_s3_bucket.source.bucket
+  content = "Ingen ko på isen"
+  key     = "test"
+}
+
+resource "aws_s3_bucket" "target" {
+  bucket = "%[1]s-target"
+}
+
+resource "aws_s3_object_copy" "test" {
+  bucket                 = aws_s3_bucket.target.bucket
+  key                    = "test"
+  server_side_encryption = %[2]q
+  source                 = "${aws_s3_bucket.source.bucket}/${aws_s3_bucket_object.source.key}"
+}
+`, rName, serverSideEncryption)
+}
+
+func testAccObjectCopyConfig_ServerSideEncryptionKMS(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kms_key" "test" {
+  description             = "Encrypts test bucket objects"
+  deletion_window_in_days = 7
+}
+
+resource "aws_s3_bucket" "source" {
+  bucket = "%[1]s-source"
+}
+
+resource "aws_s3_bucket_object" "source" {
+  bucket  = aws_s3_bucket.source.bucket
+  content = "Ingen ko på isen"
+  key     = "test"
+}
+
+resource "aws_s3_bucket" "target" {
+  bucket = "%[1]s-target"
+}
+
+resource "aws_s3_object_copy" "test" {
+  bucket                 = aws_s3_bucket.target.bucket
+  key                    = "test"
+  kms_key_id             = aws_kms_key.test.arn
+  server_side_encryption = "aws:kms"
+  source                 = "${aws_s3_bucket.source.bucket}/${aws_s3_bucket_object.source.key}"
+}
+`, rName)
+}
+
+func testAccObjectCopyConfig_CustomerKey(rName, customerKey string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "source" {
+  bucket = "%[1]s-source"
+}
+
+resource "aws_s3_bucket_object" "source" {
+  bucket  = aws_s3_bucket.source.bucket
+  content = "Ingen ko på isen"
+  key     = "test"
+}
+
+resource "aws_s3_bucket" "target" {
+  bucket = "%[1]s-target"
+}
+
+resource "aws_s3_object_copy" "test" {
+  bucket             = aws_s3_bucket.target.bucket
+  customer_algorithm = "AES256"
+  customer_key       = %[2]q
+  key                = "test"
+  source             = "${aws_s3_bucket.source.bucket}/${aws_s3_bucket_object.source.key}"
+}
+`, rName, customerKey)
+}
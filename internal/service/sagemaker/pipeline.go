@@ -0,0 +1,361 @@
+package sagemaker
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+func ResourcePipeline() *schema.Resource {
+	return &schema.Resource{
+		Create: resourcePipelineCreate,
+		Read:   resourcePipelineRead,
+		Update: resourcePipelineUpdate,
+		Delete: resourcePipelineDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"parallelism_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"max_parallel_execution_steps": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+						},
+					},
+				},
+			},
+			"pipeline_definition": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     []string{"pipeline_definition", "pipeline_definition_s3_location"},
+				ValidateFunc:     validation.StringLenBetween(1, 1048576),
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"pipeline_definition_s3_location": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				ExactlyOneOf: []string{"pipeline_definition", "pipeline_definition_s3_location"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"object_key": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						"version_id": {
+							Type:     schema.TypeString,
+							Optional: true,
+						},
+					},
+				},
+			},
+			"pipeline_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringLenBetween(0, 3072),
+			},
+			"pipeline_display_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`), "Valid characters are a-z, A-Z, 0-9, and - (hyphen)."),
+				),
+			},
+			"pipeline_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexp.MustCompile(`^[a-zA-Z0-9](-*[a-zA-Z0-9])*$`), "Valid characters are a-z, A-Z, 0-9, and - (hyphen)."),
+				),
+			},
+			"role_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"tags":     tftags.TagsSchema(),
+			"tags_all": tftags.TagsSchemaComputed(),
+		},
+
+		CustomizeDiff: customdiff.All(
+			resourcePipelineCustomizeDiff,
+			verify.SetTagsDiff,
+		),
+	}
+}
+
+func resourcePipelineCreate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	tags := defaultTagsConfig.MergeTags(tftags.New(d.Get("tags").(map[string]interface{})))
+
+	name := d.Get("pipeline_name").(string)
+	input := &sagemaker.CreatePipelineInput{
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		PipelineName:       aws.String(name),
+		RoleArn:            aws.String(d.Get("role_arn").(string)),
+	}
+
+	if v, ok := d.GetOk("parallelism_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.ParallelismConfiguration = expandPipelineParallelismConfiguration(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("pipeline_definition"); ok {
+		input.PipelineDefinition = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pipeline_definition_s3_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		input.PipelineDefinitionS3Location = expandPipelineDefinitionS3Location(v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("pipeline_description"); ok {
+		input.PipelineDescription = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("pipeline_display_name"); ok {
+		input.PipelineDisplayName = aws.String(v.(string))
+	}
+
+	if len(tags) > 0 {
+		input.Tags = Tags(tags.IgnoreAWS())
+	}
+
+	log.Printf("[DEBUG] Creating SageMaker Pipeline: %s", input)
+	_, err := conn.CreatePipeline(input)
+
+	if err != nil {
+		return fmt.Errorf("error creating SageMaker Pipeline (%s): %w", name, err)
+	}
+
+	d.SetId(name)
+
+	return resourcePipelineRead(d, meta)
+}
+
+func resourcePipelineRead(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+	defaultTagsConfig := meta.(*conns.AWSClient).DefaultTagsConfig
+	ignoreTagsConfig := meta.(*conns.AWSClient).IgnoreTagsConfig
+
+	pipeline, err := FindPipelineByName(conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] SageMaker Pipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error reading SageMaker Pipeline (%s): %w", d.Id(), err)
+	}
+
+	arn := aws.StringValue(pipeline.PipelineArn)
+	d.Set("arn", arn)
+
+	if pipeline.ParallelismConfiguration != nil {
+		if err := d.Set("parallelism_configuration", []interface{}{flattenPipelineParallelismConfiguration(pipeline.ParallelismConfiguration)}); err != nil {
+			return fmt.Errorf("error setting parallelism_configuration: %w", err)
+		}
+	} else {
+		d.Set("parallelism_configuration", nil)
+	}
+
+	// The definition read from an S3 object is returned inline; only track it
+	// when it was configured inline.
+	if _, ok := d.GetOk("pipeline_definition_s3_location"); !ok {
+		d.Set("pipeline_definition", pipeline.PipelineDefinition)
+	}
+
+	d.Set("pipeline_description", pipeline.PipelineDescription)
+	d.Set("pipeline_display_name", pipeline.PipelineDisplayName)
+	d.Set("pipeline_name", pipeline.PipelineName)
+	d.Set("role_arn", pipeline.RoleArn)
+
+	tags, err := ListTags(conn, arn)
+
+	if err != nil {
+		return fmt.Errorf("error listing tags for SageMaker Pipeline (%s): %w", d.Id(), err)
+	}
+
+	tags = tags.IgnoreAWS().IgnoreConfig(ignoreTagsConfig)
+
+	//lintignore:AWSR002
+	if err := d.Set("tags", tags.RemoveDefaultConfig(defaultTagsConfig).Map()); err != nil {
+		return fmt.Errorf("error setting tags: %w", err)
+	}
+
+	if err := d.Set("tags_all", tags.Map()); err != nil {
+		return fmt.Errorf("error setting tags_all: %w", err)
+	}
+
+	return nil
+}
+
+func resourcePipelineUpdate(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+
+	if d.HasChangesExcept("tags", "tags_all") {
+		input := &sagemaker.UpdatePipelineInput{
+			PipelineName: aws.String(d.Id()),
+			RoleArn:      aws.String(d.Get("role_arn").(string)),
+		}
+
+		if v, ok := d.GetOk("parallelism_configuration"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.ParallelismConfiguration = expandPipelineParallelismConfiguration(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("pipeline_definition"); ok {
+			input.PipelineDefinition = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("pipeline_definition_s3_location"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			input.PipelineDefinitionS3Location = expandPipelineDefinitionS3Location(v.([]interface{})[0].(map[string]interface{}))
+		}
+
+		if v, ok := d.GetOk("pipeline_description"); ok {
+			input.PipelineDescription = aws.String(v.(string))
+		}
+
+		if v, ok := d.GetOk("pipeline_display_name"); ok {
+			input.PipelineDisplayName = aws.String(v.(string))
+		}
+
+		log.Printf("[DEBUG] Updating SageMaker Pipeline: %s", input)
+		_, err := conn.UpdatePipeline(input)
+
+		if err != nil {
+			return fmt.Errorf("error updating SageMaker Pipeline (%s): %w", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags_all") {
+		o, n := d.GetChange("tags_all")
+
+		if err := UpdateTags(conn, d.Get("arn").(string), o, n); err != nil {
+			return fmt.Errorf("error updating SageMaker Pipeline (%s) tags: %w", d.Id(), err)
+		}
+	}
+
+	return resourcePipelineRead(d, meta)
+}
+
+func resourcePipelineDelete(d *schema.ResourceData, meta interface{}) error {
+	conn := meta.(*conns.AWSClient).SageMakerConn
+
+	log.Printf("[DEBUG] Deleting SageMaker Pipeline: %s", d.Id())
+	_, err := conn.DeletePipeline(&sagemaker.DeletePipelineInput{
+		ClientRequestToken: aws.String(resource.UniqueId()),
+		PipelineName:       aws.String(d.Id()),
+	})
+
+	if tfawserr.ErrCodeEquals(err, sagemaker.ErrCodeResourceNotFound) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("error deleting SageMaker Pipeline (%s): %w", d.Id(), err)
+	}
+
+	return nil
+}
+
+func resourcePipelineCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("pipeline_definition") {
+		return nil
+	}
+
+	if v, ok := diff.GetOk("pipeline_definition"); ok {
+		if _, err := structure.NormalizeJsonString(v); err != nil {
+			return fmt.Errorf("pipeline_definition is not valid JSON: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func expandPipelineDefinitionS3Location(tfMap map[string]interface{}) *sagemaker.PipelineDefinitionS3Location {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sagemaker.PipelineDefinitionS3Location{}
+
+	if v, ok := tfMap["bucket"].(string); ok && v != "" {
+		apiObject.Bucket = aws.String(v)
+	}
+
+	if v, ok := tfMap["object_key"].(string); ok && v != "" {
+		apiObject.ObjectKey = aws.String(v)
+	}
+
+	if v, ok := tfMap["version_id"].(string); ok && v != "" {
+		apiObject.VersionId = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func expandPipelineParallelismConfiguration(tfMap map[string]interface{}) *sagemaker.ParallelismConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &sagemaker.ParallelismConfiguration{}
+
+	if v, ok := tfMap["max_parallel_execution_steps"].(int); ok && v != 0 {
+		apiObject.MaxParallelExecutionSteps = aws.Int64(int64(v))
+	}
+
+	return apiObject
+}
+
+func flattenPipelineParallelismConfiguration(apiObject *sagemaker.ParallelismConfiguration) map[string]interface{} {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]interface{}{}
+
+	if v := apiObject.MaxParallelExecutionSteps; v != nil {
+		tfMap["max_parallel_execution_steps"] = aws.Int64Value(v)
+	}
+
+	return tfMap
+}
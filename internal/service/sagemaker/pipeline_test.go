@@ -0,0 +1,452 @@
+package sagemaker_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/sagemaker"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfsagemaker "github.com/hashicorp/terraform-provider-aws/internal/service/sagemaker"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccSageMakerPipeline_basic(t *testing.T) {
+	var pipeline sagemaker.DescribePipelineOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					acctest.CheckResourceAttrRegionalARN(resourceName, "arn", "sagemaker", fmt.Sprintf("pipeline/%s", rName)),
+					resource.TestCheckResourceAttr(resourceName, "parallelism_configuration.#", "0"),
+					resource.TestCheckResourceAttrSet(resourceName, "pipeline_definition"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_definition_s3_location.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_description", ""),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_display_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, "role_arn", "aws_iam_role.test", "arn"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerPipeline_update(t *testing.T) {
+	var pipeline sagemaker.DescribePipelineOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "parallelism_configuration.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_description", ""),
+				),
+			},
+			{
+				Config: testAccPipelineConfigUpdated(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "parallelism_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "parallelism_configuration.0.max_parallel_execution_steps", "2"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_description", "updated"),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_display_name", "updated"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerPipeline_definitionS3Location(t *testing.T) {
+	var pipeline sagemaker.DescribePipelineOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfigDefinitionS3Location(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_definition", ""),
+					resource.TestCheckResourceAttr(resourceName, "pipeline_definition_s3_location.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "pipeline_definition_s3_location.0.bucket", "aws_s3_bucket_object.test", "bucket"),
+					resource.TestCheckResourceAttrPair(resourceName, "pipeline_definition_s3_location.0.object_key", "aws_s3_bucket_object.test", "key"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"pipeline_definition", "pipeline_definition_s3_location"},
+			},
+		},
+	})
+}
+
+func TestAccSageMakerPipeline_invalidDefinition(t *testing.T) {
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccPipelineConfigInvalidDefinition(rName),
+				ExpectError: regexp.MustCompile(`pipeline_definition is not valid JSON`),
+			},
+			{
+				Config:      testAccPipelineConfigConflictingDefinitions(rName),
+				ExpectError: regexp.MustCompile(`only one of .pipeline_definition,pipeline_definition_s3_location. can be specified`),
+			},
+		},
+	})
+}
+
+func TestAccSageMakerPipeline_disappears(t *testing.T) {
+	var pipeline sagemaker.DescribePipelineOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					acctest.CheckResourceDisappears(acctest.Provider, tfsagemaker.ResourcePipeline(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccSageMakerPipeline_tags(t *testing.T) {
+	var pipeline sagemaker.DescribePipelineOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_sagemaker_pipeline.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, sagemaker.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckPipelineDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPipelineConfigTags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccPipelineConfigTags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccPipelineConfigTags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPipelineExists(resourceName, &pipeline),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPipelineDestroy(s *terraform.State) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "aws_sagemaker_pipeline" {
+			continue
+		}
+
+		_, err := tfsagemaker.FindPipelineByName(conn, rs.Primary.ID)
+
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		return fmt.Errorf("SageMaker Pipeline %s still exists", rs.Primary.ID)
+	}
+
+	return nil
+}
+
+func testAccCheckPipelineExists(n string, pipeline *sagemaker.DescribePipelineOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No SageMaker Pipeline ID is set")
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).SageMakerConn
+
+		output, err := tfsagemaker.FindPipelineByName(conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*pipeline = *output
+
+		return nil
+	}
+}
+
+func testAccPipelineConfigBase(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "sagemaker.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccPipelineConfig(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfigBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_pipeline" "test" {
+  pipeline_name         = %[1]q
+  pipeline_display_name = %[1]q
+  role_arn              = aws_iam_role.test.arn
+
+  pipeline_definition = jsonencode({
+    Version = "2020-12-01"
+    Steps = [{
+      Name = "Test"
+      Type = "Fail"
+      Arguments = {
+        ErrorMessage = "test"
+      }
+    }]
+  })
+}
+`, rName))
+}
+
+func testAccPipelineConfigUpdated(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfigBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_pipeline" "test" {
+  pipeline_name         = %[1]q
+  pipeline_display_name = "updated"
+  pipeline_description  = "updated"
+  role_arn              = aws_iam_role.test.arn
+
+  pipeline_definition = jsonencode({
+    Version = "2020-12-01"
+    Steps = [{
+      Name = "Test"
+      Type = "Fail"
+      Arguments = {
+        ErrorMessage = "updated"
+      }
+    }]
+  })
+
+  parallelism_configuration {
+    max_parallel_execution_steps = 2
+  }
+}
+`, rName))
+}
+
+func testAccPipelineConfigDefinitionS3Location(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfigBase(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_s3_bucket_object" "test" {
+  bucket = aws_s3_bucket.test.bucket
+  key    = "pipeline.json"
+
+  content = jsonencode({
+    Version = "2020-12-01"
+    Steps = [{
+      Name = "Test"
+      Type = "Fail"
+      Arguments = {
+        ErrorMessage = "test"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "s3:GetObject"
+      Resource = "${aws_s3_bucket.test.arn}/*"
+    }]
+  })
+}
+
+resource "aws_sagemaker_pipeline" "test" {
+  pipeline_name         = %[1]q
+  pipeline_display_name = %[1]q
+  role_arn              = aws_iam_role.test.arn
+
+  pipeline_definition_s3_location {
+    bucket     = aws_s3_bucket_object.test.bucket
+    object_key = aws_s3_bucket_object.test.key
+  }
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName))
+}
+
+func testAccPipelineConfigInvalidDefinition(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfigBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_pipeline" "test" {
+  pipeline_name       = %[1]q
+  role_arn            = aws_iam_role.test.arn
+  pipeline_definition = "{"
+}
+`, rName))
+}
+
+func testAccPipelineConfigConflictingDefinitions(rName string) string {
+	return acctest.ConfigCompose(testAccPipelineConfigBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_pipeline" "test" {
+  pipeline_name       = %[1]q
+  role_arn            = aws_iam_role.test.arn
+  pipeline_definition = "{}"
+
+  pipeline_definition_s3_location {
+    bucket     = %[1]q
+    object_key = "pipeline.json"
+  }
+}
+`, rName))
+}
+
+func testAccPipelineConfigTags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccPipelineConfigBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_pipeline" "test" {
+  pipeline_name         = %[1]q
+  pipeline_display_name = %[1]q
+  role_arn              = aws_iam_role.test.arn
+
+  pipeline_definition = jsonencode({
+    Version = "2020-12-01"
+    Steps = [{
+      Name = "Test"
+      Type = "Fail"
+      Arguments = {
+        ErrorMessage = "test"
+      }
+    }]
+  })
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccPipelineConfigTags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccPipelineConfigBase(rName), fmt.Sprintf(`
+resource "aws_sagemaker_pipeline" "test" {
+  pipeline_name         = %[1]q
+  pipeline_display_name = %[1]q
+  role_arn              = aws_iam_role.test.arn
+
+  pipeline_definition = jsonencode({
+    Version = "2020-12-01"
+    Steps = [{
+      Name = "Test"
+      Type = "Fail"
+      Arguments = {
+        ErrorMessage = "test"
+      }
+    }]
+  })
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -280,6 +280,11 @@ func resourceQueueRead(d *schema.ResourceData, meta interface{}) error {
 		d.Set("kms_data_key_reuse_period_seconds", DefaultQueueKMSDataKeyReusePeriodSeconds)
 	}
 
+	// SSE-SQS and SSE-KMS are mutually exclusive, but the API may still report a KMS key when SSE-SQS is enabled.
+	if d.Get("sqs_managed_sse_enabled").(bool) {
+		d.Set("kms_master_key_id", "")
+	}
+
 	d.Set("name", name)
 	if d.Get("fifo_queue").(bool) {
 		d.Set("name_prefix", create.NamePrefixFromNameWithSuffix(name, FIFOQueueNameSuffix))
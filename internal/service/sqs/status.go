@@ -46,6 +46,15 @@ func statusQueueAttributeState(conn *sqs.SQS, url string, expected map[string]st
 				}
 
 				switch k {
+				case sqs.QueueAttributeNameKmsMasterKeyId:
+					// A KMS key may still be reported once SSE-SQS has been enabled.
+					if e == "" && got[sqs.QueueAttributeNameSqsManagedSseEnabled] == "true" {
+						continue
+					}
+
+					if g != e {
+						return queuePolicyStateNotEqual
+					}
 				case sqs.QueueAttributeNamePolicy:
 					equivalent, err := awspolicy.PoliciesAreEquivalent(g, e)
 
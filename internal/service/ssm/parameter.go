@@ -109,6 +109,15 @@ func ResourceParameter() *schema.Resource {
 			customdiff.ForceNewIfChange("tier", func(_ context.Context, old, new, meta interface{}) bool {
 				return old.(string) == ssm.ParameterTierAdvanced && (new.(string) == ssm.ParameterTierStandard || new.(string) == ssm.ParameterTierIntelligentTiering)
 			}),
+			func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				if diff.Id() == "" || !diff.HasChange("data_type") {
+					return nil
+				}
+
+				o, n := diff.GetChange("data_type")
+
+				return validParameterDataTypeChange(o.(string), n.(string))
+			},
 			verify.SetTagsDiff,
 		),
 	}
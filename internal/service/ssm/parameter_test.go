@@ -2,6 +2,7 @@ package ssm_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -570,6 +571,40 @@ func TestAccSSMParameter_DataType_awsEC2Image(t *testing.T) {
 	})
 }
 
+func TestAccSSMParameter_DataType_update(t *testing.T) {
+	var param ssm.Parameter
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ssm_parameter.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acctest.PreCheck(t) },
+		ErrorCheck:   acctest.ErrorCheck(t, ssm.EndpointsID),
+		Providers:    acctest.Providers,
+		CheckDestroy: testAccCheckParameterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccParameterDataTypeTextAMIConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "data_type", "text"),
+				),
+			},
+			{
+				Config: testAccParameterDataTypeEC2ImageConfig(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckParameterExists(resourceName, &param),
+					resource.TestCheckResourceAttr(resourceName, "version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "data_type", "aws:ec2:image"),
+				),
+			},
+			{
+				Config:      testAccParameterDataTypeTextAMIConfig(rName),
+				ExpectError: regexp.MustCompile(`data_type cannot be changed from "aws:ec2:image" to "text"`),
+			},
+		},
+	})
+}
+
 func TestAccSSMParameter_secureWithKey(t *testing.T) {
 	var param ssm.Parameter
 	randString := sdkacctest.RandString(10)
@@ -754,6 +789,19 @@ resource "aws_ssm_parameter" "test" {
 `, rName))
 }
 
+func testAccParameterDataTypeTextAMIConfig(rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLatestAmazonLinuxHvmEbsAmi(),
+		fmt.Sprintf(`
+resource "aws_ssm_parameter" "test" {
+  name      = %[1]q
+  data_type = "text"
+  type      = "String"
+  value     = data.aws_ami.amzn-ami-minimal-hvm-ebs.id
+}
+`, rName))
+}
+
 func testAccParameterBasicTags1Config(rName, tagKey1, tagValue1 string) string {
 	return fmt.Sprintf(`
 resource "aws_ssm_parameter" "test" {
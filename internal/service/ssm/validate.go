@@ -17,3 +17,13 @@ func validName(v interface{}, k string) (ws []string, errors []error) {
 
 	return
 }
+
+// validParameterDataTypeChange checks whether an existing parameter's data type can be changed in place.
+// SSM accepts a change from text to aws:ec2:image but rejects the reverse.
+func validParameterDataTypeChange(old, new string) error {
+	if old == "aws:ec2:image" && new == "text" {
+		return fmt.Errorf("data_type cannot be changed from %q to %q", old, new)
+	}
+
+	return nil
+}
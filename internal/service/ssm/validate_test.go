@@ -29,3 +29,29 @@ func TestValidName(t *testing.T) {
 		}
 	}
 }
+
+func TestValidParameterDataTypeChange(t *testing.T) {
+	cases := []struct {
+		Old         string
+		New         string
+		ExpectError bool
+	}{
+		{Old: "text", New: "aws:ec2:image"},
+		{Old: "aws:ec2:image", New: "text", ExpectError: true},
+		{Old: "text", New: "text"},
+		{Old: "aws:ec2:image", New: "aws:ec2:image"},
+		{Old: "", New: "text"},
+	}
+
+	for _, tc := range cases {
+		err := validParameterDataTypeChange(tc.Old, tc.New)
+
+		if tc.ExpectError && err == nil {
+			t.Errorf("expected error changing data_type from %q to %q", tc.Old, tc.New)
+		}
+
+		if !tc.ExpectError && err != nil {
+			t.Errorf("unexpected error changing data_type from %q to %q: %s", tc.Old, tc.New, err)
+		}
+	}
+}
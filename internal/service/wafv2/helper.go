@@ -472,6 +472,45 @@ func wafv2AllowConfigSchema() *schema.Schema {
 	}
 }
 
+func wafv2CaptchaConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"custom_request_handling": wafv2CustomRequestHandlingSchema(),
+			},
+		},
+	}
+}
+
+func wafv2RuleCaptchaConfigSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"immunity_time_property": {
+					Type:     schema.TypeList,
+					Optional: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"immunity_time": {
+								Type:         schema.TypeInt,
+								Optional:     true,
+								ValidateFunc: validation.IntBetween(60, 259200),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func wafv2CountConfigSchema() *schema.Schema {
 	return &schema.Schema{
 		Type:     schema.TypeList,
@@ -637,6 +676,10 @@ func expandWafv2Rule(m map[string]interface{}) *wafv2.Rule {
 		VisibilityConfig: expandWafv2VisibilityConfig(m["visibility_config"].([]interface{})),
 	}
 
+	if v, ok := m["captcha_config"].([]interface{}); ok && len(v) > 0 {
+		rule.CaptchaConfig = expandWafv2CaptchaConfig(v)
+	}
+
 	if v, ok := m["rule_label"].(*schema.Set); ok && v.Len() > 0 {
 		rule.RuleLabels = expandWafv2RuleLabels(v.List())
 	}
@@ -644,6 +687,30 @@ func expandWafv2Rule(m map[string]interface{}) *wafv2.Rule {
 	return rule
 }
 
+func expandWafv2CaptchaConfig(l []interface{}) *wafv2.CaptchaConfig {
+	config := &wafv2.CaptchaConfig{}
+
+	if len(l) == 0 || l[0] == nil {
+		return config
+	}
+
+	m, ok := l[0].(map[string]interface{})
+	if !ok {
+		return config
+	}
+
+	if v, ok := m["immunity_time_property"].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		inner := v[0].(map[string]interface{})
+		config.ImmunityTimeProperty = &wafv2.ImmunityTimeProperty{}
+
+		if v, ok := inner["immunity_time"].(int); ok && v != 0 {
+			config.ImmunityTimeProperty.ImmunityTime = aws.Int64(int64(v))
+		}
+	}
+
+	return config
+}
+
 func expandWafv2RuleLabels(l []interface{}) []*wafv2.Label {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -680,6 +747,10 @@ func expandWafv2RuleAction(l []interface{}) *wafv2.RuleAction {
 		action.Block = expandWafv2BlockAction(v.([]interface{}))
 	}
 
+	if v, ok := m["captcha"]; ok && len(v.([]interface{})) > 0 {
+		action.Captcha = expandWafv2CaptchaAction(v.([]interface{}))
+	}
+
 	if v, ok := m["count"]; ok && len(v.([]interface{})) > 0 {
 		action.Count = expandWafv2CountAction(v.([]interface{}))
 	}
@@ -706,6 +777,25 @@ func expandWafv2AllowAction(l []interface{}) *wafv2.AllowAction {
 	return action
 }
 
+func expandWafv2CaptchaAction(l []interface{}) *wafv2.CaptchaAction {
+	action := &wafv2.CaptchaAction{}
+
+	if len(l) == 0 || l[0] == nil {
+		return action
+	}
+
+	m, ok := l[0].(map[string]interface{})
+	if !ok {
+		return action
+	}
+
+	if v, ok := m["custom_request_handling"].([]interface{}); ok && len(v) > 0 {
+		action.CustomRequestHandling = expandWafv2CustomRequestHandling(v)
+	}
+
+	return action
+}
+
 func expandWafv2CountAction(l []interface{}) *wafv2.CountAction {
 	action := &wafv2.CountAction{}
 
@@ -1216,6 +1306,7 @@ func flattenWafv2Rules(r []*wafv2.Rule) interface{} {
 	for i, rule := range r {
 		m := make(map[string]interface{})
 		m["action"] = flattenWafv2RuleAction(rule.Action)
+		m["captcha_config"] = flattenWafv2CaptchaConfig(rule.CaptchaConfig)
 		m["name"] = aws.StringValue(rule.Name)
 		m["priority"] = int(aws.Int64Value(rule.Priority))
 		m["rule_label"] = flattenWafv2RuleLabels(rule.RuleLabels)
@@ -1242,6 +1333,10 @@ func flattenWafv2RuleAction(a *wafv2.RuleAction) interface{} {
 		m["block"] = flattenWafv2Block(a.Block)
 	}
 
+	if a.Captcha != nil {
+		m["captcha"] = flattenWafv2Captcha(a.Captcha)
+	}
+
 	if a.Count != nil {
 		m["count"] = flattenWafv2Count(a.Count)
 	}
@@ -1276,6 +1371,36 @@ func flattenWafv2Block(a *wafv2.BlockAction) []interface{} {
 	return []interface{}{m}
 }
 
+func flattenWafv2Captcha(a *wafv2.CaptchaAction) []interface{} {
+	if a == nil {
+		return []interface{}{}
+	}
+	m := map[string]interface{}{}
+
+	if a.CustomRequestHandling != nil {
+		m["custom_request_handling"] = flattenWafv2CustomRequestHandling(a.CustomRequestHandling)
+	}
+
+	return []interface{}{m}
+}
+
+func flattenWafv2CaptchaConfig(c *wafv2.CaptchaConfig) []interface{} {
+	if c == nil {
+		return []interface{}{}
+	}
+	m := map[string]interface{}{}
+
+	if c.ImmunityTimeProperty != nil {
+		m["immunity_time_property"] = []interface{}{
+			map[string]interface{}{
+				"immunity_time": int(aws.Int64Value(c.ImmunityTimeProperty.ImmunityTime)),
+			},
+		}
+	}
+
+	return []interface{}{m}
+}
+
 func flattenWafv2Count(a *wafv2.CountAction) []interface{} {
 	if a == nil {
 		return []interface{}{}
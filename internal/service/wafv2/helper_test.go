@@ -0,0 +1,91 @@
+package wafv2
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/wafv2"
+)
+
+func TestExpandFlattenWafv2CaptchaConfig(t *testing.T) {
+	testCases := []struct {
+		Name     string
+		TfList   []interface{}
+		Expected *wafv2.CaptchaConfig
+	}{
+		{
+			Name: "immunity time",
+			TfList: []interface{}{
+				map[string]interface{}{
+					"immunity_time_property": []interface{}{
+						map[string]interface{}{
+							"immunity_time": 300,
+						},
+					},
+				},
+			},
+			Expected: &wafv2.CaptchaConfig{
+				ImmunityTimeProperty: &wafv2.ImmunityTimeProperty{
+					ImmunityTime: aws.Int64(300),
+				},
+			},
+		},
+		{
+			Name: "empty",
+			TfList: []interface{}{
+				map[string]interface{}{},
+			},
+			Expected: &wafv2.CaptchaConfig{},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.Name, func(t *testing.T) {
+			apiObject := expandWafv2CaptchaConfig(testCase.TfList)
+
+			if !reflect.DeepEqual(apiObject, testCase.Expected) {
+				t.Fatalf("expanded: got %#v, expected %#v", apiObject, testCase.Expected)
+			}
+
+			tfList := flattenWafv2CaptchaConfig(apiObject)
+
+			if !reflect.DeepEqual(tfList, testCase.TfList) {
+				t.Fatalf("flattened: got %#v, expected %#v", tfList, testCase.TfList)
+			}
+		})
+	}
+}
+
+func TestExpandFlattenWafv2RuleCaptchaConfig(t *testing.T) {
+	rule := &wafv2.Rule{
+		Name:     aws.String("rule-1"),
+		Priority: aws.Int64(1),
+		Action: &wafv2.RuleAction{
+			Captcha: &wafv2.CaptchaAction{},
+		},
+		CaptchaConfig: &wafv2.CaptchaConfig{
+			ImmunityTimeProperty: &wafv2.ImmunityTimeProperty{
+				ImmunityTime: aws.Int64(600),
+			},
+		},
+	}
+
+	tfList := flattenWafv2Rules([]*wafv2.Rule{rule}).([]map[string]interface{})
+
+	if got, expected := len(tfList), 1; got != expected {
+		t.Fatalf("got %d rules, expected %d", got, expected)
+	}
+
+	action := expandWafv2RuleAction(tfList[0]["action"].([]interface{}))
+
+	if action.Captcha == nil {
+		t.Fatalf("expected captcha action to round-trip, got %#v", action)
+	}
+
+	captchaConfig := expandWafv2CaptchaConfig(tfList[0]["captcha_config"].([]interface{}))
+
+	if !reflect.DeepEqual(captchaConfig, rule.CaptchaConfig) {
+		t.Fatalf("got %#v, expected %#v", captchaConfig, rule.CaptchaConfig)
+	}
+}
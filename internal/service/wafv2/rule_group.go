@@ -91,12 +91,14 @@ func ResourceRuleGroup() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-									"allow": wafv2AllowConfigSchema(),
-									"block": wafv2BlockConfigSchema(),
-									"count": wafv2CountConfigSchema(),
+									"allow":   wafv2AllowConfigSchema(),
+									"block":   wafv2BlockConfigSchema(),
+									"captcha": wafv2CaptchaConfigSchema(),
+									"count":   wafv2CountConfigSchema(),
 								},
 							},
 						},
+						"captcha_config": wafv2RuleCaptchaConfigSchema(),
 						"name": {
 							Type:         schema.TypeString,
 							Required:     true,
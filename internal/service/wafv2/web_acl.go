@@ -106,12 +106,14 @@ func ResourceWebACL() *schema.Resource {
 							MaxItems: 1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-									"allow": wafv2AllowConfigSchema(),
-									"block": wafv2BlockConfigSchema(),
-									"count": wafv2CountConfigSchema(),
+									"allow":   wafv2AllowConfigSchema(),
+									"block":   wafv2BlockConfigSchema(),
+									"captcha": wafv2CaptchaConfigSchema(),
+									"count":   wafv2CountConfigSchema(),
 								},
 							},
 						},
+						"captcha_config": wafv2RuleCaptchaConfigSchema(),
 						"name": {
 							Type:         schema.TypeString,
 							Required:     true,
@@ -529,6 +531,10 @@ func expandWafv2WebACLRule(m map[string]interface{}) *wafv2.Rule {
 		VisibilityConfig: expandWafv2VisibilityConfig(m["visibility_config"].([]interface{})),
 	}
 
+	if v, ok := m["captcha_config"].([]interface{}); ok && len(v) > 0 {
+		rule.CaptchaConfig = expandWafv2CaptchaConfig(v)
+	}
+
 	if v, ok := m["rule_label"].(*schema.Set); ok && v.Len() > 0 {
 		rule.RuleLabels = expandWafv2RuleLabels(v.List())
 	}
@@ -811,6 +817,7 @@ func flattenWafv2WebACLRules(r []*wafv2.Rule) interface{} {
 	for i, rule := range r {
 		m := make(map[string]interface{})
 		m["action"] = flattenWafv2RuleAction(rule.Action)
+		m["captcha_config"] = flattenWafv2CaptchaConfig(rule.CaptchaConfig)
 		m["override_action"] = flattenWafv2OverrideAction(rule.OverrideAction)
 		m["name"] = aws.StringValue(rule.Name)
 		m["priority"] = int(aws.Int64Value(rule.Priority))